@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	gqlclient "github.com/pluralsh/console/go/client"
+	"golang.org/x/sync/singleflight"
+)
+
+// cache is a read-through cache for Console lookups that do not change during a single provider run,
+// i.e. deployment settings. Concurrent lookups of the same key are de-duplicated. Errors and empty
+// results are not cached, so failed lookups are retried on the next call.
+type cache struct {
+	group  singleflight.Group
+	mu     sync.RWMutex
+	values map[string]any
+}
+
+func newCache() *cache {
+	return &cache{values: map[string]any{}}
+}
+
+func (c *cache) get(key string) (any, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	value, ok := c.values[key]
+	return value, ok
+}
+
+func (c *cache) set(key string, value any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[key] = value
+}
+
+// cached returns the value stored under the key or fetches and stores it.
+func cached[T any](c *cache, key string, fetch func() (*T, error)) (*T, error) {
+	if value, ok := c.get(key); ok {
+		return value.(*T), nil
+	}
+
+	value, err, _ := c.group.Do(key, func() (any, error) {
+		if value, ok := c.get(key); ok {
+			return value, nil
+		}
+
+		value, err := fetch()
+		if err != nil || value == nil {
+			return value, err
+		}
+
+		c.set(key, value)
+		return value, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return value.(*T), nil
+}
+
+func (c *Client) GetAgentRuntimeByName(ctx context.Context, name, clusterId string) (*gqlclient.GetAgentRuntimeByName, error) {
+	return cached(c.cache, "agentRuntime/"+clusterId+"/"+name, func() (*gqlclient.GetAgentRuntimeByName, error) {
+		return c.ConsoleClient.GetAgentRuntimeByName(ctx, name, clusterId)
+	})
+}
+
+func (c *Client) GetPrAutomationByName(ctx context.Context, name string) (*gqlclient.GetPrAutomationByName, error) {
+	return cached(c.cache, "prAutomation/"+name, func() (*gqlclient.GetPrAutomationByName, error) {
+		return c.ConsoleClient.GetPrAutomationByName(ctx, name)
+	})
+}
+
+// GetClusterForAgentValues returns the cluster used to render templated agent Helm values. It is cached by ID,
+// as agent installation only runs after the cluster has been created or updated within a provider run. Other
+// lookups, i.e. reads and polling, must use GetCluster to see the latest cluster state.
+func (c *Client) GetClusterForAgentValues(ctx context.Context, id string) (*gqlclient.ClusterFragment, error) {
+	return cached(c.cache, "cluster/"+id, func() (*gqlclient.ClusterFragment, error) {
+		res, err := c.ConsoleClient.GetCluster(ctx, &id)
+		if err != nil || res == nil {
+			return nil, err
+		}
+
+		return res.Cluster, nil
+	})
+}
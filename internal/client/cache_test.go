@@ -0,0 +1,66 @@
+package client
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCachedDeduplicatesLookups(t *testing.T) {
+	c := newCache()
+	var fetches atomic.Int32
+	fetch := func() (*string, error) {
+		fetches.Add(1)
+		time.Sleep(10 * time.Millisecond)
+		value := "settings"
+		return &value, nil
+	}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if value, err := cached(c, "key", fetch); err != nil || *value != "settings" {
+				t.Errorf("expected cached value, got %v, %v", value, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if _, err := cached(c, "key", fetch); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if fetches.Load() != 1 {
+		t.Errorf("expected value to be fetched once, got %d fetches", fetches.Load())
+	}
+}
+
+func TestCachedDoesNotCacheErrors(t *testing.T) {
+	c := newCache()
+	fetches := 0
+	fetch := func() (*string, error) {
+		fetches++
+		if fetches == 1 {
+			return nil, fmt.Errorf("temporary error")
+		}
+
+		value := "settings"
+		return &value, nil
+	}
+
+	if _, err := cached(c, "key", fetch); err == nil {
+		t.Fatal("expected error to be returned")
+	}
+
+	if value, err := cached(c, "key", fetch); err != nil || *value != "settings" {
+		t.Fatalf("expected lookup to be retried, got %v, %v", value, err)
+	}
+
+	if fetches != 2 {
+		t.Errorf("expected 2 fetches, got %d", fetches)
+	}
+}
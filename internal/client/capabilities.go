@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+const getConsoleVersionDocument = `query GetConsoleVersion {
+	configuration { consoleVersion }
+}`
+
+const getConsoleSchemaDocument = `query GetConsoleSchema {
+	__schema {
+		queryType { fields { name } }
+		mutationType { fields { name } }
+	}
+}`
+
+// ErrUnknownCapabilities is returned when supported Console features cannot be detected,
+// i.e. because the Console could not be reached or schema introspection is disabled.
+var ErrUnknownCapabilities = errors.New("cannot detect supported Console features")
+
+// Feature is a Console feature that is not available in all supported Console versions.
+// It is detected by the presence of the root GraphQL field it requires.
+type Feature struct {
+	Name  string
+	Field string
+}
+
+var (
+	FeatureWorkbenches          = Feature{Name: "Workbenches", Field: "createWorkbench"}
+	FeatureCloudConnections     = Feature{Name: "Cloud connections", Field: "upsertCloudConnection"}
+	FeatureServiceAccounts      = Feature{Name: "Service accounts", Field: "createServiceAccount"}
+	FeatureObservabilityWebhook = Feature{Name: "Observability webhooks", Field: "upsertObservabilityWebhook"}
+)
+
+// Capabilities describe the Console the provider is connected to.
+type Capabilities struct {
+	// Version is the Console version, it is empty if it could not be detected.
+	Version string
+
+	// fields contains names of all root query and mutation fields.
+	fields map[string]bool
+}
+
+type getConsoleVersion struct {
+	Configuration *struct {
+		ConsoleVersion *string `json:"consoleVersion"`
+	} `json:"configuration"`
+}
+
+type schemaType struct {
+	Fields []struct {
+		Name string `json:"name"`
+	} `json:"fields"`
+}
+
+type getConsoleSchema struct {
+	Schema struct {
+		QueryType    *schemaType `json:"queryType"`
+		MutationType *schemaType `json:"mutationType"`
+	} `json:"__schema"`
+}
+
+// capabilities returns the Console version and supported features. They are detected on first use and cached
+// for the rest of the provider run. Failed detection is not cached, so it is retried on the next use.
+func (c *Client) capabilities(ctx context.Context) (*Capabilities, error) {
+	return cached(c.cache, "capabilities", func() (*Capabilities, error) {
+		return c.detectCapabilities(ctx)
+	})
+}
+
+// detectCapabilities detects supported features from the Console schema. The version is best effort,
+// it is left empty if it cannot be read.
+func (c *Client) detectCapabilities(ctx context.Context) (*Capabilities, error) {
+	schema := new(getConsoleSchema)
+	if err := c.post(ctx, "GetConsoleSchema", getConsoleSchemaDocument, schema, map[string]any{}); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnknownCapabilities, err)
+	}
+
+	capabilities := &Capabilities{fields: map[string]bool{}}
+	for _, t := range []*schemaType{schema.Schema.QueryType, schema.Schema.MutationType} {
+		if t == nil {
+			continue
+		}
+
+		for _, field := range t.Fields {
+			capabilities.fields[field.Name] = true
+		}
+	}
+
+	version := new(getConsoleVersion)
+	if err := c.post(ctx, "GetConsoleVersion", getConsoleVersionDocument, version, map[string]any{}); err == nil &&
+		version.Configuration != nil && version.Configuration.ConsoleVersion != nil {
+		capabilities.Version = *version.Configuration.ConsoleVersion
+	}
+
+	return capabilities, nil
+}
+
+// ConsoleVersion returns the detected Console version or an empty string if it is unknown.
+func (c *Client) ConsoleVersion(ctx context.Context) string {
+	capabilities, err := c.capabilities(ctx)
+	if err != nil {
+		return ""
+	}
+
+	return capabilities.Version
+}
+
+// RequireFeature returns an error describing the unsupported feature, or nil if it is supported.
+// If supported features cannot be detected, it returns an error wrapping ErrUnknownCapabilities.
+func (c *Client) RequireFeature(ctx context.Context, feature Feature) error {
+	capabilities, err := c.capabilities(ctx)
+	if err != nil {
+		return err
+	}
+
+	if capabilities.fields[feature.Field] {
+		return nil
+	}
+
+	version := capabilities.Version
+	if version == "" {
+		version = "unknown"
+	}
+
+	return fmt.Errorf("%s are not supported by the Console (version %s), upgrade the Console to use this resource", feature.Name, version)
+}
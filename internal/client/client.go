@@ -10,6 +10,9 @@ import (
 
 type Client struct {
 	gqlclient.ConsoleClient
+
+	readOnly bool
+	cache    *cache
 }
 
 func (c *Client) CreateServiceDeployment(ctx context.Context, id, handle *string, attrs gqlclient.ServiceDeploymentAttributes) (*gqlclient.ServiceDeploymentExtended, error) {
@@ -35,6 +38,12 @@ func (c *Client) CreateServiceDeployment(ctx context.Context, id, handle *string
 }
 
 func (c *Client) GetDeploymentSettings(ctx context.Context) (*gqlclient.GetDeploymentSettings, error) {
+	return cached(c.cache, "deploymentSettings", func() (*gqlclient.GetDeploymentSettings, error) {
+		return c.getDeploymentSettings(ctx)
+	})
+}
+
+func (c *Client) getDeploymentSettings(ctx context.Context) (*gqlclient.GetDeploymentSettings, error) {
 	res, err := c.ConsoleClient.GetDeploymentSettings(ctx)
 	if err == nil && res != nil && res.DeploymentSettings != nil {
 		return res, nil
@@ -67,5 +76,16 @@ func toDeploymentSettingsFragment(minimal *gqlclient.DeploymentSettingsMinimalFr
 func NewClient(client gqlclient.ConsoleClient) *Client {
 	return &Client{
 		ConsoleClient: client,
+		cache:         newCache(),
 	}
 }
+
+// post executes a GraphQL operation that is not part of the generated Console client.
+func (c *Client) post(ctx context.Context, operationName, query string, respData any, vars map[string]any) error {
+	consoleClient, ok := c.ConsoleClient.(*gqlclient.Client)
+	if !ok {
+		return fmt.Errorf("unsupported console client type: %T", c.ConsoleClient)
+	}
+
+	return consoleClient.Client.Post(ctx, operationName, query, respData, vars)
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+const getClusterPingDocument = `query GetClusterPing($id: ID!) {
+	cluster(id: $id) {
+		id
+		pingedAt
+	}
+}`
+
+// pingedAtLayouts lists timestamp formats used by Console, it does not always include a time zone.
+var pingedAtLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999"}
+
+// ClusterPing describes when the deployment agent running in a cluster last pinged the Console.
+type ClusterPing struct {
+	ID       string  `json:"id"`
+	PingedAt *string `json:"pingedAt"`
+}
+
+// PingedSince checks if the agent has pinged the Console at or after the given time.
+func (p *ClusterPing) PingedSince(since time.Time) bool {
+	pingedAt := lo.FromPtr(p.PingedAt)
+	for _, layout := range pingedAtLayouts {
+		if t, err := time.Parse(layout, pingedAt); err == nil {
+			return !t.Before(since)
+		}
+	}
+
+	return false
+}
+
+type getClusterPing struct {
+	Cluster *ClusterPing `json:"cluster"`
+}
+
+// GetClusterPing returns the time of the last deployment agent ping of the cluster.
+func (c *Client) GetClusterPing(ctx context.Context, id string) (*ClusterPing, error) {
+	res := new(getClusterPing)
+	if err := c.post(ctx, "GetClusterPing", getClusterPingDocument, res, map[string]any{"id": id}); err != nil {
+		return nil, err
+	}
+
+	if res.Cluster == nil {
+		return nil, fmt.Errorf("cluster %s not found", id)
+	}
+
+	return res.Cluster, nil
+}
@@ -0,0 +1,28 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+func TestClusterPingPingedSince(t *testing.T) {
+	since := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		pingedAt *string
+		pinged   bool
+	}{
+		{pingedAt: lo.ToPtr("2024-05-01T12:00:05Z"), pinged: true},
+		{pingedAt: lo.ToPtr("2024-05-01T12:00:00.000000"), pinged: true},
+		{pingedAt: lo.ToPtr("2024-05-01T11:59:59Z"), pinged: false},
+		{pingedAt: lo.ToPtr("invalid"), pinged: false},
+		{pingedAt: nil, pinged: false},
+	}
+
+	for _, c := range cases {
+		if result := (&ClusterPing{PingedAt: c.pingedAt}).PingedSince(since); result != c.pinged {
+			t.Errorf("expected %v for pinged at %v, got %v", c.pinged, lo.FromPtr(c.pingedAt), result)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+const getClusterUpgradeDocument = `query GetClusterUpgrade($id: ID!) {
+	cluster(id: $id) {
+		id
+		version
+		currentVersion
+		upgradePlan { compatibilities deprecations incompatibilities kubeletSkew }
+	}
+}`
+
+// ClusterUpgradePlan contains results of the Console upgrade preflight checks. Every check is true if it
+// passed, false if it found a blocker and nil if it was not run yet.
+type ClusterUpgradePlan struct {
+	Compatibilities   *bool `json:"compatibilities"`
+	Deprecations      *bool `json:"deprecations"`
+	Incompatibilities *bool `json:"incompatibilities"`
+	KubeletSkew       *bool `json:"kubeletSkew"`
+}
+
+// Blockers returns descriptions of all failed preflight checks.
+func (p *ClusterUpgradePlan) Blockers() []string {
+	if p == nil {
+		return nil
+	}
+
+	checks := []struct {
+		passed      *bool
+		description string
+	}{
+		{p.Compatibilities, "add-ons are not compatible with the next Kubernetes version"},
+		{p.Deprecations, "deprecated APIs are still in use"},
+		{p.Incompatibilities, "add-ons need to be upgraded first"},
+		{p.KubeletSkew, "kubelet version skew is too large"},
+	}
+
+	result := make([]string, 0)
+	for _, check := range checks {
+		if check.passed != nil && !*check.passed {
+			result = append(result, check.description)
+		}
+	}
+
+	return result
+}
+
+// ClusterUpgrade describes the Kubernetes version of a cluster and its upgrade preflight checks.
+type ClusterUpgrade struct {
+	ID             string              `json:"id"`
+	Version        *string             `json:"version"`
+	CurrentVersion *string             `json:"currentVersion"`
+	UpgradePlan    *ClusterUpgradePlan `json:"upgradePlan"`
+}
+
+// Upgraded checks if the cluster runs the desired version. Desired versions usually
+// omit the patch version, i.e. 1.30 is matched by 1.30.2.
+func (u *ClusterUpgrade) Upgraded(version string) bool {
+	current := strings.TrimPrefix(lo.FromPtr(u.CurrentVersion), "v")
+	version = strings.TrimPrefix(version, "v")
+	return current != "" && (current == version || strings.HasPrefix(current, version+"."))
+}
+
+type getClusterUpgrade struct {
+	Cluster *ClusterUpgrade `json:"cluster"`
+}
+
+// GetClusterUpgrade returns the Kubernetes version of the cluster together with the upgrade preflight checks.
+func (c *Client) GetClusterUpgrade(ctx context.Context, id string) (*ClusterUpgrade, error) {
+	res := new(getClusterUpgrade)
+	if err := c.post(ctx, "GetClusterUpgrade", getClusterUpgradeDocument, res, map[string]any{"id": id}); err != nil {
+		return nil, err
+	}
+
+	if res.Cluster == nil {
+		return nil, fmt.Errorf("cluster %s not found", id)
+	}
+
+	return res.Cluster, nil
+}
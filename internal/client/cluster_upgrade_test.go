@@ -0,0 +1,46 @@
+package client
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/samber/lo"
+)
+
+func TestClusterUpgradeUpgraded(t *testing.T) {
+	cases := []struct {
+		current  *string
+		version  string
+		upgraded bool
+	}{
+		{current: lo.ToPtr("1.30.2"), version: "1.30", upgraded: true},
+		{current: lo.ToPtr("v1.30.2"), version: "1.30.2", upgraded: true},
+		{current: lo.ToPtr("1.29.8"), version: "1.30", upgraded: false},
+		{current: lo.ToPtr("1.300.0"), version: "1.30", upgraded: false},
+		{current: nil, version: "1.30", upgraded: false},
+	}
+
+	for _, c := range cases {
+		if result := (&ClusterUpgrade{CurrentVersion: c.current}).Upgraded(c.version); result != c.upgraded {
+			t.Errorf("expected %v for current version %v and desired version %s, got %v", c.upgraded, lo.FromPtr(c.current), c.version, result)
+		}
+	}
+}
+
+func TestClusterUpgradePlanBlockers(t *testing.T) {
+	plan := &ClusterUpgradePlan{
+		Compatibilities:   lo.ToPtr(true),
+		Deprecations:      lo.ToPtr(false),
+		Incompatibilities: nil,
+		KubeletSkew:       lo.ToPtr(false),
+	}
+
+	expected := []string{"deprecated APIs are still in use", "kubelet version skew is too large"}
+	if blockers := plan.Blockers(); !reflect.DeepEqual(blockers, expected) {
+		t.Errorf("expected blockers %v, got %v", expected, blockers)
+	}
+
+	if blockers := (*ClusterUpgradePlan)(nil).Blockers(); len(blockers) != 0 {
+		t.Errorf("expected no blockers if preflight checks did not run, got %v", blockers)
+	}
+}
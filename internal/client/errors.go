@@ -2,8 +2,13 @@ package client
 
 import (
 	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
 
 	client "github.com/Yamashou/gqlgenc/clientv2"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 type KnownError string
@@ -12,6 +17,57 @@ const (
 	ErrorNotFound KnownError = "could not find resource"
 )
 
+// ErrorKind classifies errors returned by the Console API.
+type ErrorKind string
+
+const (
+	ErrorKindUnknown    ErrorKind = "unknown"
+	ErrorKindNotFound   ErrorKind = "not_found"
+	ErrorKindForbidden  ErrorKind = "forbidden"
+	ErrorKindValidation ErrorKind = "validation"
+	ErrorKindConflict   ErrorKind = "conflict"
+	ErrorKindTransient  ErrorKind = "transient"
+)
+
+// Error is a single error returned by the Console API.
+type Error struct {
+	Kind    ErrorKind
+	Message string
+
+	// Field is the name of the rejected input field, if known. It is only set for validation and conflict errors.
+	Field string
+
+	// Path is the GraphQL path of the field that failed to resolve.
+	Path []string
+
+	// StatusCode is the HTTP status code of the response if the request failed at the HTTP level.
+	StatusCode int
+
+	err error
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+var (
+	// changesetErrorPattern matches validation errors formatted from Ecto changesets, i.e. "name can't be blank".
+	changesetErrorPattern = regexp.MustCompile(`^([a-z][a-z0-9_]*) (.+)$`)
+
+	validationMessages = []string{
+		"can't be blank", "is invalid", "has invalid format", "is reserved", "does not exist",
+		"should be at", "must be", "is not", "are not", "has an invalid entry", "has already been taken",
+	}
+
+	forbiddenMessages = []string{"forbidden", "unauthorized", "not authorized", "you don't have", "you do not have", "not permitted", "permission"}
+
+	notFoundMessages = []string{string(ErrorNotFound), "not found"}
+)
+
 type wrappedErrorResponse struct {
 	err *client.ErrorResponse
 }
@@ -30,12 +86,137 @@ func (er *wrappedErrorResponse) Has(err KnownError) bool {
 	return false
 }
 
+// Errors returns all errors contained in the response classified by their kind.
+func (er *wrappedErrorResponse) Errors() []*Error {
+	result := make([]*Error, 0)
+	if er.err.NetworkError != nil {
+		result = append(result, newHTTPError(er.err, er.err.NetworkError))
+	}
+
+	if er.err.GqlErrors != nil {
+		for _, g := range *er.err.GqlErrors {
+			result = append(result, newGraphQLError(er.err, g))
+		}
+	}
+
+	return result
+}
+
 func newAPIError(err *client.ErrorResponse) *wrappedErrorResponse {
 	return &wrappedErrorResponse{
 		err: err,
 	}
 }
 
+func newHTTPError(err error, httpErr *client.HTTPError) *Error {
+	kind := ErrorKindUnknown
+	switch {
+	case httpErr.Code == http.StatusTooManyRequests || httpErr.Code >= http.StatusInternalServerError:
+		kind = ErrorKindTransient
+	case httpErr.Code == http.StatusUnauthorized || httpErr.Code == http.StatusForbidden:
+		kind = ErrorKindForbidden
+	case httpErr.Code == http.StatusNotFound:
+		kind = ErrorKindNotFound
+	case httpErr.Code == http.StatusConflict:
+		kind = ErrorKindConflict
+	}
+
+	return &Error{
+		Kind:       kind,
+		Message:    fmt.Sprintf("%d: %s", httpErr.Code, httpErr.Message),
+		StatusCode: httpErr.Code,
+		err:        err,
+	}
+}
+
+func newGraphQLError(err error, gqlErr *gqlerror.Error) *Error {
+	result := &Error{Kind: ErrorKindUnknown, Message: gqlErr.Message, err: err}
+	for _, p := range gqlErr.Path {
+		result.Path = append(result.Path, fmt.Sprint(p))
+	}
+
+	if field, ok := gqlErr.Extensions["field"].(string); ok {
+		result.Field = field
+	}
+
+	if code, ok := gqlErr.Extensions["code"].(string); ok {
+		switch strings.ToUpper(code) {
+		case "NOT_FOUND":
+			result.Kind = ErrorKindNotFound
+		case "FORBIDDEN", "UNAUTHORIZED", "UNAUTHENTICATED":
+			result.Kind = ErrorKindForbidden
+		case "BAD_USER_INPUT", "VALIDATION", "INVALID":
+			result.Kind = ErrorKindValidation
+		case "CONFLICT":
+			result.Kind = ErrorKindConflict
+		case "INTERNAL_SERVER_ERROR", "TIMEOUT", "UNAVAILABLE":
+			result.Kind = ErrorKindTransient
+		}
+	}
+
+	if result.Kind == ErrorKindUnknown {
+		result.Kind = errorKindFromMessage(gqlErr.Message)
+	}
+
+	if result.Field == "" && (result.Kind == ErrorKindValidation || result.Kind == ErrorKindConflict) {
+		if matches := changesetErrorPattern.FindStringSubmatch(gqlErr.Message); matches != nil {
+			result.Field = matches[1]
+		}
+	}
+
+	return result
+}
+
+func errorKindFromMessage(message string) ErrorKind {
+	lower := strings.ToLower(message)
+	switch {
+	case containsAny(lower, notFoundMessages):
+		return ErrorKindNotFound
+	case containsAny(lower, forbiddenMessages):
+		return ErrorKindForbidden
+	case strings.Contains(lower, "has already been taken"):
+		return ErrorKindConflict
+	case changesetErrorPattern.MatchString(message) && containsAny(lower, validationMessages):
+		return ErrorKindValidation
+	default:
+		return ErrorKindUnknown
+	}
+}
+
+func containsAny(s string, substrings []string) bool {
+	for _, substring := range substrings {
+		if strings.Contains(s, substring) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AsErrors extracts errors returned by the Console API from err. It returns nil if err is not a Console API error.
+func AsErrors(err error) []*Error {
+	if err == nil {
+		return nil
+	}
+
+	errorResponse := new(client.ErrorResponse)
+	if !errors.As(err, &errorResponse) {
+		return nil
+	}
+
+	return newAPIError(errorResponse).Errors()
+}
+
+func hasKind(err error, kind ErrorKind) bool {
+	for _, e := range AsErrors(err) {
+		if e.Kind == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
 func IsNotFound(err error) bool {
 	if err == nil {
 		return false
@@ -49,3 +230,23 @@ func IsNotFound(err error) bool {
 
 	return newAPIError(errorResponse).Has(ErrorNotFound)
 }
+
+// IsForbidden checks if the Console rejected the request due to missing permissions.
+func IsForbidden(err error) bool {
+	return hasKind(err, ErrorKindForbidden)
+}
+
+// IsValidation checks if the Console rejected any of the input fields.
+func IsValidation(err error) bool {
+	return hasKind(err, ErrorKindValidation)
+}
+
+// IsConflict checks if the request conflicts with an existing object, i.e. its name is already taken.
+func IsConflict(err error) bool {
+	return hasKind(err, ErrorKindConflict)
+}
+
+// IsTransient checks if the request failed due to a temporary server error and can be retried.
+func IsTransient(err error) bool {
+	return hasKind(err, ErrorKindTransient)
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"fmt"
+	"testing"
+
+	client "github.com/Yamashou/gqlgenc/clientv2"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func graphQLError(errs ...*gqlerror.Error) error {
+	list := gqlerror.List(errs)
+	return fmt.Errorf("wrapped: %w", &client.ErrorResponse{GqlErrors: &list})
+}
+
+func TestAsErrors(t *testing.T) {
+	cases := []struct {
+		name  string
+		err   error
+		kind  ErrorKind
+		field string
+	}{
+		{
+			name: "not found",
+			err:  graphQLError(&gqlerror.Error{Message: "could not find resource"}),
+			kind: ErrorKindNotFound,
+		},
+		{
+			name: "forbidden",
+			err:  graphQLError(&gqlerror.Error{Message: "forbidden"}),
+			kind: ErrorKindForbidden,
+		},
+		{
+			name: "forbidden with changeset-like message",
+			err:  graphQLError(&gqlerror.Error{Message: "user is not authorized to perform this action"}),
+			kind: ErrorKindForbidden,
+		},
+		{
+			name:  "validation",
+			err:   graphQLError(&gqlerror.Error{Message: "name can't be blank"}),
+			kind:  ErrorKindValidation,
+			field: "name",
+		},
+		{
+			name:  "conflict",
+			err:   graphQLError(&gqlerror.Error{Message: "handle has already been taken"}),
+			kind:  ErrorKindConflict,
+			field: "handle",
+		},
+		{
+			name:  "extensions",
+			err:   graphQLError(&gqlerror.Error{Message: "invalid ref", Extensions: map[string]any{"code": "BAD_USER_INPUT", "field": "gitRef"}}),
+			kind:  ErrorKindValidation,
+			field: "gitRef",
+		},
+		{
+			name: "transient",
+			err:  &client.ErrorResponse{NetworkError: &client.HTTPError{Code: 502, Message: "bad gateway"}},
+			kind: ErrorKindTransient,
+		},
+		{
+			name: "unknown",
+			err:  graphQLError(&gqlerror.Error{Message: "something went wrong"}),
+			kind: ErrorKindUnknown,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			errs := AsErrors(c.err)
+			if len(errs) != 1 {
+				t.Fatalf("expected 1 error, got %d", len(errs))
+			}
+
+			if errs[0].Kind != c.kind {
+				t.Errorf("expected kind %s, got %s", c.kind, errs[0].Kind)
+			}
+
+			if errs[0].Field != c.field {
+				t.Errorf("expected field %q, got %q", c.field, errs[0].Field)
+			}
+		})
+	}
+}
+
+func TestErrorHelpers(t *testing.T) {
+	err := graphQLError(&gqlerror.Error{Message: "name can't be blank"}, &gqlerror.Error{Message: "you don't have permission to do this"})
+
+	if !IsValidation(err) || !IsForbidden(err) {
+		t.Error("expected error to be both validation and forbidden error")
+	}
+
+	if IsNotFound(err) || IsConflict(err) || IsTransient(err) {
+		t.Error("expected error not to be not found, conflict or transient error")
+	}
+
+	if AsErrors(fmt.Errorf("plain error")) != nil {
+		t.Error("expected plain error not to be a Console API error")
+	}
+}
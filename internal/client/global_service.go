@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+
+	gqlclient "github.com/pluralsh/console/go/client"
+	"github.com/samber/lo"
+)
+
+const getGlobalServiceServicesDocument = `query GetGlobalServiceServices($id: ID!, $after: String) {
+	globalService(id: $id) {
+		services(first: 100, after: $after) {
+			pageInfo { hasNextPage endCursor }
+			edges { node { id name status cluster { handle } } }
+		}
+	}
+}`
+
+// GlobalServiceService is a service deployment created from a global service.
+type GlobalServiceService struct {
+	ID      string                            `json:"id"`
+	Name    string                            `json:"name"`
+	Status  gqlclient.ServiceDeploymentStatus `json:"status"`
+	Cluster *struct {
+		Handle *string `json:"handle"`
+	} `json:"cluster"`
+}
+
+type getGlobalServiceServices struct {
+	GlobalService *struct {
+		Services *struct {
+			PageInfo struct {
+				HasNextPage bool    `json:"hasNextPage"`
+				EndCursor   *string `json:"endCursor"`
+			} `json:"pageInfo"`
+			Edges []*struct {
+				Node *GlobalServiceService `json:"node"`
+			} `json:"edges"`
+		} `json:"services"`
+	} `json:"globalService"`
+}
+
+// GetGlobalServiceServices lists all service deployments created from the global service.
+func (c *Client) GetGlobalServiceServices(ctx context.Context, id string) ([]*GlobalServiceService, error) {
+	result := make([]*GlobalServiceService, 0)
+
+	var after *string
+	for {
+		res := new(getGlobalServiceServices)
+		vars := map[string]any{"id": id, "after": after}
+		if err := c.post(ctx, "GetGlobalServiceServices", getGlobalServiceServicesDocument, res, vars); err != nil {
+			return nil, err
+		}
+
+		if res.GlobalService == nil || res.GlobalService.Services == nil {
+			return result, nil
+		}
+
+		for _, edge := range res.GlobalService.Services.Edges {
+			if edge != nil && edge.Node != nil {
+				result = append(result, edge.Node)
+			}
+		}
+
+		if !res.GlobalService.Services.PageInfo.HasNextPage || lo.FromPtr(res.GlobalService.Services.PageInfo.EndCursor) == "" {
+			return result, nil
+		}
+
+		after = res.GlobalService.Services.PageInfo.EndCursor
+	}
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+
+	gqlclient "github.com/pluralsh/console/go/client"
+	"github.com/samber/lo"
+)
+
+const getGroupMembersDocument = `query GetGroupMembers($groupId: ID!, $after: String) {
+	groupMembers(groupId: $groupId, first: 100, after: $after) {
+		pageInfo { hasNextPage endCursor }
+		edges { node { id user { id } group { id } } }
+	}
+}`
+
+type getGroupMembers struct {
+	GroupMembers *struct {
+		PageInfo struct {
+			HasNextPage bool    `json:"hasNextPage"`
+			EndCursor   *string `json:"endCursor"`
+		} `json:"pageInfo"`
+		Edges []*struct {
+			Node *gqlclient.GroupMemberFragment `json:"node"`
+		} `json:"edges"`
+	} `json:"groupMembers"`
+}
+
+// GetGroupMember finds membership of the user in the group. It returns nil if the user is not a member.
+func (c *Client) GetGroupMember(ctx context.Context, groupId, userId string) (*gqlclient.GroupMemberFragment, error) {
+	var after *string
+	for {
+		res := new(getGroupMembers)
+		vars := map[string]any{"groupId": groupId, "after": after}
+		if err := c.post(ctx, "GetGroupMembers", getGroupMembersDocument, res, vars); err != nil {
+			return nil, err
+		}
+
+		if res.GroupMembers == nil {
+			return nil, nil
+		}
+
+		for _, edge := range res.GroupMembers.Edges {
+			if edge != nil && edge.Node != nil && edge.Node.User != nil && edge.Node.User.ID == userId {
+				return edge.Node, nil
+			}
+		}
+
+		if !res.GroupMembers.PageInfo.HasNextPage || lo.FromPtr(res.GroupMembers.PageInfo.EndCursor) == "" {
+			return nil, nil
+		}
+
+		after = res.GroupMembers.PageInfo.EndCursor
+	}
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+
+	gqlclient "github.com/pluralsh/console/go/client"
+)
+
+const getClusterBindingsDocument = `query GetClusterBindings($id: ID) {
+	cluster(id: $id) {
+		readBindings { id user { id } group { id } }
+		writeBindings { id user { id } group { id } }
+	}
+}`
+
+const getServiceBindingsDocument = `query GetServiceBindings($id: ID) {
+	serviceDeployment(id: $id) {
+		readBindings { id user { id } group { id } }
+		writeBindings { id user { id } group { id } }
+	}
+}`
+
+const setRbacDocument = `mutation SetRbac($rbac: RbacAttributes!, $serviceId: ID, $clusterId: ID) {
+	updateRbac(rbac: $rbac, serviceId: $serviceId, clusterId: $clusterId)
+}`
+
+// Bindings holds read and write policy bindings of a cluster or a service.
+type Bindings struct {
+	ReadBindings  []*gqlclient.PolicyBindingFragment `json:"readBindings"`
+	WriteBindings []*gqlclient.PolicyBindingFragment `json:"writeBindings"`
+}
+
+// GetClusterBindings returns current read and write bindings of the cluster.
+func (c *Client) GetClusterBindings(ctx context.Context, clusterId string) (*Bindings, error) {
+	res := new(struct {
+		Cluster *Bindings `json:"cluster"`
+	})
+	if err := c.post(ctx, "GetClusterBindings", getClusterBindingsDocument, res, map[string]any{"id": clusterId}); err != nil {
+		return nil, err
+	}
+
+	return res.Cluster, nil
+}
+
+// GetServiceBindings returns current read and write bindings of the service.
+func (c *Client) GetServiceBindings(ctx context.Context, serviceId string) (*Bindings, error) {
+	res := new(struct {
+		ServiceDeployment *Bindings `json:"serviceDeployment"`
+	})
+	if err := c.post(ctx, "GetServiceBindings", getServiceBindingsDocument, res, map[string]any{"id": serviceId}); err != nil {
+		return nil, err
+	}
+
+	return res.ServiceDeployment, nil
+}
+
+// SetRbac replaces read and write bindings of the cluster or the service. Unlike UpdateRbac
+// it always sends both lists, so passing empty lists clears all existing bindings.
+func (c *Client) SetRbac(ctx context.Context, readBindings, writeBindings []*gqlclient.PolicyBindingAttributes, serviceId, clusterId *string) error {
+	if readBindings == nil {
+		readBindings = []*gqlclient.PolicyBindingAttributes{}
+	}
+	if writeBindings == nil {
+		writeBindings = []*gqlclient.PolicyBindingAttributes{}
+	}
+
+	vars := map[string]any{
+		"rbac":      map[string]any{"readBindings": readBindings, "writeBindings": writeBindings},
+		"serviceId": serviceId,
+		"clusterId": clusterId,
+	}
+
+	return c.post(ctx, "SetRbac", setRbacDocument, new(struct {
+		UpdateRbac *bool `json:"updateRbac"`
+	}), vars)
+}
@@ -0,0 +1,12 @@
+package client
+
+// SetReadOnly configures whether the client is allowed to be used for operations that change the Console state.
+func (c *Client) SetReadOnly(readOnly bool) {
+	c.readOnly = readOnly
+}
+
+// ReadOnly checks if the provider is configured in read-only mode. It is safe to call on nil client,
+// i.e. if the resource was not configured.
+func (c *Client) ReadOnly() bool {
+	return c != nil && c.readOnly
+}
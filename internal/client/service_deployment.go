@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+
+	gqlclient "github.com/pluralsh/console/go/client"
+)
+
+const getServiceDeploymentRelationsDocument = `query GetServiceDeploymentRelations($id: ID) {
+	serviceDeployment(id: $id) {
+		dependencies { name }
+		contexts { id name }
+	}
+}`
+
+// ServiceDeploymentRelations holds dependencies and service contexts attached to a service.
+type ServiceDeploymentRelations struct {
+	Dependencies []*struct {
+		Name string `json:"name"`
+	} `json:"dependencies"`
+	Contexts []*struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"contexts"`
+}
+
+// GetServiceDeploymentRelations returns dependencies and service contexts of the service.
+func (c *Client) GetServiceDeploymentRelations(ctx context.Context, id string) (*ServiceDeploymentRelations, error) {
+	res := new(struct {
+		ServiceDeployment *ServiceDeploymentRelations `json:"serviceDeployment"`
+	})
+	if err := c.post(ctx, "GetServiceDeploymentRelations", getServiceDeploymentRelationsDocument, res, map[string]any{"id": id}); err != nil {
+		return nil, err
+	}
+
+	return res.ServiceDeployment, nil
+}
+
+const clearServiceDeploymentRelationsDocument = `mutation ClearServiceDeploymentRelations($id: ID!, $attributes: ServiceUpdateAttributes!) {
+	updateServiceDeployment(id: $id, attributes: $attributes) { id }
+}`
+
+// ClearServiceDeploymentRelations removes all dependencies and/or service contexts of the service.
+// Empty lists are sent explicitly, as the Console leaves relations untouched when they are omitted.
+func (c *Client) ClearServiceDeploymentRelations(ctx context.Context, id string, dependencies, contexts bool) error {
+	attributes := map[string]any{}
+	if dependencies {
+		attributes["dependencies"] = []any{}
+	}
+	if contexts {
+		attributes["contextBindings"] = []any{}
+	}
+
+	return c.post(ctx, "ClearServiceDeploymentRelations", clearServiceDeploymentRelationsDocument, new(struct {
+		UpdateServiceDeployment *struct {
+			ID string `json:"id"`
+		} `json:"updateServiceDeployment"`
+	}), map[string]any{"id": id, "attributes": attributes})
+}
+
+const getServiceDeploymentStatusDocument = `query GetServiceDeploymentStatus($id: ID, $cluster: String, $name: String) {
+	serviceDeployment(id: $id, cluster: $cluster, name: $name) {
+		id
+		name
+		status
+		errors { source message }
+		components { kind namespace name state synced }
+	}
+}`
+
+// ServiceDeploymentStatus holds the status of a service along with its errors and components.
+type ServiceDeploymentStatus struct {
+	ID     string                            `json:"id"`
+	Name   string                            `json:"name"`
+	Status gqlclient.ServiceDeploymentStatus `json:"status"`
+	Errors []*struct {
+		Source  string `json:"source"`
+		Message string `json:"message"`
+	} `json:"errors"`
+	Components []*struct {
+		Kind      string  `json:"kind"`
+		Namespace *string `json:"namespace"`
+		Name      string  `json:"name"`
+		State     *string `json:"state"`
+		Synced    bool    `json:"synced"`
+	} `json:"components"`
+}
+
+// GetServiceDeploymentStatus returns the current status, errors and components of the service.
+func (c *Client) GetServiceDeploymentStatus(ctx context.Context, id string) (*ServiceDeploymentStatus, error) {
+	return c.getServiceDeploymentStatus(ctx, map[string]any{"id": id})
+}
+
+// GetServiceDeploymentStatusByHandle returns the current status, errors and components of the service
+// identified by the cluster handle and the service name.
+func (c *Client) GetServiceDeploymentStatusByHandle(ctx context.Context, cluster, name string) (*ServiceDeploymentStatus, error) {
+	return c.getServiceDeploymentStatus(ctx, map[string]any{"cluster": cluster, "name": name})
+}
+
+func (c *Client) getServiceDeploymentStatus(ctx context.Context, vars map[string]any) (*ServiceDeploymentStatus, error) {
+	res := new(struct {
+		ServiceDeployment *ServiceDeploymentStatus `json:"serviceDeployment"`
+	})
+	if err := c.post(ctx, "GetServiceDeploymentStatus", getServiceDeploymentStatusDocument, res, vars); err != nil {
+		return nil, err
+	}
+
+	return res.ServiceDeployment, nil
+}
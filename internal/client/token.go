@@ -0,0 +1,32 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+const exchangeTokenDocument = `query ExchangeToken($token: String!, $email: String!) {
+	exchangeToken(token: $token, email: $email) { jwt }
+}`
+
+type exchangeToken struct {
+	ExchangeToken *struct {
+		Jwt *string `json:"jwt"`
+	} `json:"exchangeToken"`
+}
+
+// ExchangeToken exchanges an OIDC token issued by a trusted identity provider for a short-lived
+// Console access token of the user with the given email. It requires a federated credential
+// matching the token issuer and claims to be configured for the user.
+func (c *Client) ExchangeToken(ctx context.Context, token, email string) (string, error) {
+	res := new(exchangeToken)
+	if err := c.post(ctx, "ExchangeToken", exchangeTokenDocument, res, map[string]any{"token": token, "email": email}); err != nil {
+		return "", err
+	}
+
+	if res.ExchangeToken == nil || res.ExchangeToken.Jwt == nil || *res.ExchangeToken.Jwt == "" {
+		return "", fmt.Errorf("console did not return an access token for %s", email)
+	}
+
+	return *res.ExchangeToken.Jwt, nil
+}
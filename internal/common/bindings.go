@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	console "github.com/pluralsh/console/go/client"
+	"github.com/samber/lo"
 )
 
 type Bindings struct {
@@ -59,6 +60,18 @@ func (cb *Bindings) From(readBindings []*console.PolicyBindingFragment, writeBin
 	cb.Write = bindingsFrom(writeBindings, cb.Write, ctx, d)
 }
 
+// FromPrior updates bindings with the ones returned by the API. Unlike From, bindings removed outside
+// of Terraform are dropped from the state and binding IDs are only kept if they were already tracked
+// in the prior state, as users usually reference users and groups only.
+func (cb *Bindings) FromPrior(readBindings []*console.PolicyBindingFragment, writeBindings []*console.PolicyBindingFragment, ctx context.Context, d *diag.Diagnostics) {
+	if cb == nil {
+		return
+	}
+
+	cb.Read = bindingsFromPrior(readBindings, cb.Read, ctx, d)
+	cb.Write = bindingsFromPrior(writeBindings, cb.Write, ctx, d)
+}
+
 func bindingsFrom(bindings []*console.PolicyBindingFragment, config types.Set, ctx context.Context, d *diag.Diagnostics) types.Set {
 	if len(bindings) == 0 {
 		// Rewriting config to state to avoid inconsistent result errors.
@@ -66,28 +79,9 @@ func bindingsFrom(bindings []*console.PolicyBindingFragment, config types.Set, c
 		return config
 	}
 
-	values := make([]attr.Value, len(bindings))
-	for i, binding := range bindings {
-		value := PolicyBinding{
-			ID: types.StringPointerValue(binding.ID),
-		}
-
-		if binding.User != nil {
-			value.UserID = types.StringValue(binding.User.ID)
-		}
-
-		if binding.Group != nil {
-			value.GroupID = types.StringValue(binding.Group.ID)
-		}
-
-		objValue, diags := types.ObjectValueFrom(ctx, PolicyBindingAttrTypes, value)
-		values[i] = objValue
-		d.Append(diags...)
-	}
-
-	setValue, diags := types.SetValue(types.ObjectType{AttrTypes: PolicyBindingAttrTypes}, values)
-	d.Append(diags...)
-	return setValue
+	return policyBindingsFrom(bindings, func(binding *console.PolicyBindingFragment, _ PolicyBinding) types.String {
+		return types.StringPointerValue(binding.ID)
+	}, ctx, d)
 }
 
 func BindingsFromReadOnly(bindings []*console.PolicyBindingFragment, planned types.Set, ctx context.Context, d *diag.Diagnostics) types.Set {
@@ -97,11 +91,44 @@ func BindingsFromReadOnly(bindings []*console.PolicyBindingFragment, planned typ
 		return planned
 	}
 
+	return policyBindingsFrom(bindings, func(binding *console.PolicyBindingFragment, _ PolicyBinding) types.String {
+		return types.StringPointerValue(binding.ID)
+	}, ctx, d)
+}
+
+func bindingsFromPrior(bindings []*console.PolicyBindingFragment, prior types.Set, ctx context.Context, d *diag.Diagnostics) types.Set {
+	if len(bindings) == 0 {
+		if prior.IsNull() || prior.IsUnknown() || len(prior.Elements()) == 0 {
+			return prior
+		}
+
+		return types.SetValueMust(types.ObjectType{AttrTypes: PolicyBindingAttrTypes}, []attr.Value{})
+	}
+
+	priorIds := map[string]types.String{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		priorBindings := make([]PolicyBinding, len(prior.Elements()))
+		d.Append(prior.ElementsAs(ctx, &priorBindings, false)...)
+		for _, binding := range priorBindings {
+			priorIds[binding.key()] = binding.ID
+		}
+	}
+
+	return policyBindingsFrom(bindings, func(binding *console.PolicyBindingFragment, value PolicyBinding) types.String {
+		if id, ok := priorIds[value.key()]; ok && !id.IsNull() {
+			return types.StringValue(lo.FromPtrOr(binding.ID, id.ValueString()))
+		}
+
+		return types.StringNull()
+	}, ctx, d)
+}
+
+// policyBindingsFrom converts bindings returned by the API into a set, using idFrom to resolve binding IDs.
+func policyBindingsFrom(bindings []*console.PolicyBindingFragment, idFrom func(*console.PolicyBindingFragment, PolicyBinding) types.String,
+	ctx context.Context, d *diag.Diagnostics) types.Set {
 	values := make([]attr.Value, len(bindings))
 	for i, binding := range bindings {
-		value := PolicyBinding{
-			ID: types.StringPointerValue(binding.ID),
-		}
+		value := PolicyBinding{}
 
 		if binding.User != nil {
 			value.UserID = types.StringValue(binding.User.ID)
@@ -111,6 +138,8 @@ func BindingsFromReadOnly(bindings []*console.PolicyBindingFragment, planned typ
 			value.GroupID = types.StringValue(binding.Group.ID)
 		}
 
+		value.ID = idFrom(binding, value)
+
 		objValue, diags := types.ObjectValueFrom(ctx, PolicyBindingAttrTypes, value)
 		values[i] = objValue
 		d.Append(diags...)
@@ -137,6 +166,11 @@ var PolicyBindingAttrTypes = map[string]attr.Type{
 	"user_id":  types.StringType,
 }
 
+// key identifies the binding by its user and group as binding IDs are usually not configured.
+func (cpb *PolicyBinding) key() string {
+	return cpb.UserID.ValueString() + "/" + cpb.GroupID.ValueString()
+}
+
 func (cpb *PolicyBinding) Attributes() *console.PolicyBindingAttributes {
 	return &console.PolicyBindingAttributes{
 		ID:      cpb.ID.ValueStringPointer(),
@@ -136,14 +136,28 @@ func ClusterNodePoolsFrom(nodePools []*console.NodePoolFragment, configNodePools
 
 	result := make(map[string]attr.Value)
 	for _, nodePool := range nodePools {
+		labels := MapFrom(nodePool.Labels, ctx, d)
+		taints := clusterNodePoolTaintsFrom(nodePool, ctx, d)
+		cloudSettings := types.ObjectNull(NodePoolCloudSettingsAttrTypes)
+		if configNodePool, ok := configNodePoolsElements[nodePool.Name]; ok {
+			// Rewriting config to state to avoid inconsistent results when empty labels or taints are sent.
+			if len(nodePool.Labels) == 0 {
+				labels = configNodePool.Labels
+			}
+			if len(nodePool.Taints) == 0 {
+				taints = configNodePool.Taints
+			}
+			cloudSettings = configNodePool.CloudSettings // Rewriting config to state to avoid unknown values.
+		}
+
 		objValue, diags := (&ClusterNodePool{
 			Name:          types.StringValue(nodePool.Name),
 			MinSize:       types.Int64Value(nodePool.MinSize),
 			MaxSize:       types.Int64Value(nodePool.MaxSize),
 			InstanceType:  types.StringValue(nodePool.InstanceType),
-			Labels:        MapFrom(nodePool.Labels, ctx, d),
-			Taints:        clusterNodePoolTaintsFrom(nodePool, ctx, d),
-			CloudSettings: configNodePoolsElements[nodePool.Name].CloudSettings, // Rewriting config to state to avoid unknown values.
+			Labels:        labels,
+			Taints:        taints,
+			CloudSettings: cloudSettings,
 		}).Element()
 		d.Append(diags...)
 		result[nodePool.Name] = objValue
@@ -0,0 +1,71 @@
+package common
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Defaults are configured on the provider level and applied to all resources that do not set their own values.
+type Defaults struct {
+	ProjectId string
+	Tags      map[string]string
+}
+
+// TagsAll merges default tags with the resource tags. Resource tags take precedence.
+// It returns null if there are no tags at all, and unknown if resource tags are unknown.
+func (d *Defaults) TagsAll(ctx context.Context, tags types.Map, diags *diag.Diagnostics) types.Map {
+	if tags.IsUnknown() {
+		return types.MapUnknown(types.StringType)
+	}
+
+	if tags.IsNull() && (d == nil || len(d.Tags) == 0) {
+		return types.MapNull(types.StringType)
+	}
+
+	result := map[string]string{}
+	if d != nil {
+		maps.Copy(result, d.Tags)
+	}
+
+	elements := make(map[string]string, len(tags.Elements()))
+	diags.Append(tags.ElementsAs(ctx, &elements, false)...)
+	maps.Copy(result, elements)
+
+	value, valueDiags := types.MapValueFrom(ctx, types.StringType, result)
+	diags.Append(valueDiags...)
+	return value
+}
+
+// TagsWithoutDefaults returns tags from tagsAll that were not added by default tags. Tags present in prior
+// resource tags are always kept, so overriding a default tag with the same value does not cause a diff.
+func (d *Defaults) TagsWithoutDefaults(tagsAll, prior types.Map, diags *diag.Diagnostics) types.Map {
+	if tagsAll.IsNull() || tagsAll.IsUnknown() || d == nil || len(d.Tags) == 0 {
+		return tagsAll
+	}
+
+	priorElements := map[string]attr.Value{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		priorElements = prior.Elements()
+	}
+
+	result := map[string]attr.Value{}
+	for key, value := range tagsAll.Elements() {
+		_, inPrior := priorElements[key]
+		defaultValue, isDefault := d.Tags[key]
+		if inPrior || !isDefault || !value.Equal(types.StringValue(defaultValue)) {
+			result[key] = value
+		}
+	}
+
+	if len(result) == 0 && prior.IsNull() {
+		return types.MapNull(types.StringType)
+	}
+
+	value, valueDiags := types.MapValue(types.StringType, result)
+	diags.Append(valueDiags...)
+	return value
+}
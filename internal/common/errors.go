@@ -0,0 +1,58 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"terraform-provider-plural/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var camelCasePattern = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+// AttributeSchema is implemented by resource schemas, i.e. tfsdk.Plan.Schema. It is used to check
+// if the input field rejected by the Console matches a resource attribute.
+type AttributeSchema interface {
+	TypeAtPath(ctx context.Context, p path.Path) (attr.Type, diag.Diagnostics)
+}
+
+// AddClientError reports an error returned by the Console API. If every error points to a rejected input field
+// that matches a resource attribute, they are reported as attribute errors, so users can see which field was rejected.
+func AddClientError(ctx context.Context, d *diag.Diagnostics, schema AttributeSchema, message string, err error) {
+	errs := client.AsErrors(err)
+	if len(errs) == 0 {
+		d.AddError("Client Error", fmt.Sprintf("%s, got error: %s", message, err))
+		return
+	}
+
+	for _, e := range errs {
+		if !isAttributeError(ctx, schema, e) {
+			d.AddError("Client Error", fmt.Sprintf("%s, got error: %s", message, err))
+			return
+		}
+	}
+
+	for _, e := range errs {
+		d.AddAttributeError(attributePath(e.Field), "Client Error", fmt.Sprintf("%s, got error: %s", message, e.Message))
+	}
+}
+
+// isAttributeError checks if the error points to a rejected input field that exists in the schema.
+func isAttributeError(ctx context.Context, schema AttributeSchema, e *client.Error) bool {
+	if e.Field == "" || (e.Kind != client.ErrorKindValidation && e.Kind != client.ErrorKindConflict) || schema == nil {
+		return false
+	}
+
+	_, diags := schema.TypeAtPath(ctx, attributePath(e.Field))
+	return !diags.HasError()
+}
+
+// attributePath converts the Console input field name, i.e. gitRef, to the attribute path.
+func attributePath(field string) path.Path {
+	return path.Root(strings.ToLower(camelCasePattern.ReplaceAllString(field, "${1}_${2}")))
+}
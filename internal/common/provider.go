@@ -1,17 +1,30 @@
 package common
 
-import console "terraform-provider-plural/internal/client"
+import (
+	"net/http"
+
+	console "terraform-provider-plural/internal/client"
+)
 
 type ProviderData struct {
 	Client     *console.Client
 	ConsoleUrl string
 	KubeClient *KubeClient
+
+	// HTTPClient is an unauthenticated client that uses the same TLS, proxy and retry settings
+	// as the Console client. It is used to download resources exposed by the Console, i.e. agent chart.
+	HTTPClient *http.Client
+
+	// Defaults are applied to resources that do not set their own values.
+	Defaults *Defaults
 }
 
-func NewProviderData(client *console.Client, consoleUrl string, kubeClient *KubeClient) *ProviderData {
+func NewProviderData(client *console.Client, consoleUrl string, kubeClient *KubeClient, httpClient *http.Client, defaults *Defaults) *ProviderData {
 	return &ProviderData{
 		Client:     client,
 		ConsoleUrl: consoleUrl,
 		KubeClient: kubeClient,
+		HTTPClient: httpClient,
+		Defaults:   defaults,
 	}
 }
@@ -0,0 +1,27 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+
+	console "terraform-provider-plural/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ErrReadOnly is returned by operations that would change the Console or cluster state in read-only mode.
+var ErrReadOnly = errors.New("provider is configured in read-only mode")
+
+// CheckReadOnly adds an error and returns true if the provider is configured in read-only mode.
+// Resources call it before any client call in Create, Update and Delete.
+func CheckReadOnly(client *console.Client, operation string, d *diag.Diagnostics) bool {
+	if !client.ReadOnly() {
+		return false
+	}
+
+	d.AddError(
+		"Provider In Read-Only Mode",
+		fmt.Sprintf("Cannot %s resource, %s. Remove read_only from the provider configuration or unset PLURAL_READ_ONLY to make changes.", operation, ErrReadOnly),
+	)
+	return true
+}
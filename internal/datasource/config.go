@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func NewConfigDataSource() datasource.DataSource {
@@ -38,6 +39,11 @@ func (d *configDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				MarkdownDescription: "Access token used to authenticate to plural.",
 				Computed:            true,
 			},
+			"console_version": schema.StringAttribute{
+				Description:         "Version of the Plural Console the provider is connected to. It is empty if the version could not be detected and null if the provider is not configured.",
+				MarkdownDescription: "Version of the Plural Console the provider is connected to. It is empty if the version could not be detected and null if the provider is not configured.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -67,5 +73,9 @@ func (d *configDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	data.From(&resp.Diagnostics)
+	data.ConsoleVersion = types.StringNull()
+	if d.client != nil {
+		data.ConsoleVersion = types.StringValue(d.client.ConsoleVersion(ctx))
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,491 @@
+package fakeconsole
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// registerResolvers registers resolvers for all root fields used by the provider.
+func registerResolvers(s *Server) {
+	// Deployment settings
+	s.resolvers["deploymentSettings"] = func(s *Server, _ map[string]any) (any, error) {
+		for _, obj := range s.store[KindDeploymentSettings] {
+			return obj, nil
+		}
+		return s.put(KindDeploymentSettings, Object{"agentVsn": "v0.0.0"}), nil
+	}
+
+	// Configuration and schema introspection, used to detect Console version and supported features.
+	// Every registered resolver is reported as both a query and a mutation field.
+	s.resolvers["configuration"] = func(s *Server, _ map[string]any) (any, error) {
+		for _, obj := range s.store[KindConfiguration] {
+			return obj, nil
+		}
+		return s.put(KindConfiguration, Object{"consoleVersion": "0.0.0"}), nil
+	}
+	s.resolvers["__schema"] = func(s *Server, _ map[string]any) (any, error) {
+		fields := make([]Object, 0, len(s.resolvers))
+		for _, name := range slices.Sorted(maps.Keys(s.resolvers)) {
+			fields = append(fields, Object{"name": name})
+		}
+		return Object{"queryType": Object{"fields": fields}, "mutationType": Object{"fields": fields}}, nil
+	}
+
+	// Projects
+	s.resolvers["createProject"] = createResolver(KindProject)
+	s.resolvers["updateProject"] = updateResolver(KindProject)
+	s.resolvers["project"] = func(s *Server, args map[string]any) (any, error) {
+		if id := stringArg(args, "id"); id != "" {
+			return s.get(KindProject, id)
+		}
+		return s.findBy(KindProject, "name", args["name"])
+	}
+
+	// Clusters
+	s.resolvers["createCluster"] = func(s *Server, args map[string]any) (any, error) {
+		attrs := decodeNodePools(decodeJSONFields(objectArg(args, "attributes"), "metadata"))
+		cluster := s.create(KindCluster, attrs)
+		cluster["deployToken"] = "deploy-token-" + cluster["id"].(string)
+		return cluster, nil
+	}
+	s.resolvers["updateCluster"] = func(s *Server, args map[string]any) (any, error) {
+		return s.update(KindCluster, stringArg(args, "id"), decodeNodePools(decodeJSONFields(objectArg(args, "attributes"), "metadata")))
+	}
+	s.resolvers["cluster"] = func(s *Server, args map[string]any) (any, error) {
+		if id := stringArg(args, "id"); id != "" {
+			return s.get(KindCluster, id)
+		}
+		return s.findBy(KindCluster, "handle", args["handle"])
+	}
+	s.resolvers["deleteCluster"] = deleteResolver(KindCluster)
+	s.resolvers["detachCluster"] = deleteResolver(KindCluster)
+
+	// Git repositories
+	s.resolvers["createGitRepository"] = func(s *Server, args map[string]any) (any, error) {
+		repository := s.create(KindGitRepository, objectArg(args, "attributes"))
+		repository["health"] = "PULLABLE"
+		return repository, nil
+	}
+	s.resolvers["updateGitRepository"] = updateResolver(KindGitRepository)
+	s.resolvers["deleteGitRepository"] = deleteResolver(KindGitRepository)
+	s.resolvers["gitRepository"] = func(s *Server, args map[string]any) (any, error) {
+		if id := stringArg(args, "id"); id != "" {
+			return s.get(KindGitRepository, id)
+		}
+		return s.findBy(KindGitRepository, "url", args["url"])
+	}
+
+	// Service deployments
+	s.resolvers["createServiceDeployment"] = func(s *Server, args map[string]any) (any, error) {
+		cluster, err := s.clusterOf(args)
+		if err != nil {
+			return nil, err
+		}
+
+		attrs := objectArg(args, "attributes")
+		attrs["clusterId"] = cluster["id"]
+		if _, ok := attrs["version"].(string); !ok {
+			attrs["version"] = "0.0.1"
+		}
+		service := s.create(KindServiceDeployment, attrs)
+		service["status"] = "HEALTHY"
+		s.bindContexts(service)
+		return service, nil
+	}
+	s.resolvers["updateServiceDeployment"] = func(s *Server, args map[string]any) (any, error) {
+		service, err := s.serviceOf(args)
+		if err != nil {
+			return nil, err
+		}
+		service, err = s.update(KindServiceDeployment, service["id"].(string), objectArg(args, "attributes"))
+		if err != nil {
+			return nil, err
+		}
+		s.bindContexts(service)
+		return service, nil
+	}
+	s.resolvers["serviceDeployment"] = func(s *Server, args map[string]any) (any, error) {
+		return s.serviceOf(args)
+	}
+	s.resolvers["deleteServiceDeployment"] = deleteResolver(KindServiceDeployment)
+
+	// Global services
+	s.resolvers["createGlobalService"] = func(s *Server, args map[string]any) (any, error) {
+		attrs := objectArg(args, "attributes")
+		if id := stringArg(args, "serviceId"); id != "" {
+			attrs["serviceId"] = id
+		}
+		return s.create(KindGlobalService, attrs), nil
+	}
+	s.resolvers["updateGlobalService"] = updateResolver(KindGlobalService)
+	s.resolvers["deleteGlobalService"] = deleteResolver(KindGlobalService)
+	s.resolvers["globalService"] = func(s *Server, args map[string]any) (any, error) {
+		global, err := s.get(KindGlobalService, stringArg(args, "id"))
+		if err != nil {
+			return nil, err
+		}
+
+		// Services created from the global service are linked to it through "ownerId".
+		edges := make([]any, 0)
+		for _, service := range s.store[KindServiceDeployment] {
+			if service["ownerId"] == global["id"] {
+				edges = append(edges, Object{"node": service})
+			}
+		}
+
+		result := copyObject(global)
+		result["services"] = Object{"edges": edges, "pageInfo": Object{"hasNextPage": false}}
+		return result, nil
+	}
+
+	// Service contexts
+	s.resolvers["saveServiceContext"] = func(s *Server, args map[string]any) (any, error) {
+		attrs := decodeJSONFields(objectArg(args, "attributes"), "configuration")
+		attrs["name"] = stringArg(args, "name")
+		return s.upsert(KindServiceContext, "name", attrs), nil
+	}
+	s.resolvers["serviceContext"] = func(s *Server, args map[string]any) (any, error) {
+		return s.findBy(KindServiceContext, "name", args["name"])
+	}
+	s.resolvers["deleteServiceContext"] = deleteResolver(KindServiceContext)
+
+	// Infrastructure stacks
+	s.resolvers["createStack"] = createResolver(KindInfrastructureStack)
+	s.resolvers["updateStack"] = updateResolver(KindInfrastructureStack)
+	s.resolvers["deleteStack"] = deleteResolver(KindInfrastructureStack)
+	s.resolvers["detachStack"] = deleteResolver(KindInfrastructureStack)
+	s.resolvers["infrastructureStack"] = func(s *Server, args map[string]any) (any, error) {
+		if id := stringArg(args, "id"); id != "" {
+			return s.get(KindInfrastructureStack, id)
+		}
+		return s.findBy(KindInfrastructureStack, "name", args["name"])
+	}
+	s.resolvers["triggerRun"] = func(s *Server, args map[string]any) (any, error) {
+		stack, err := s.get(KindInfrastructureStack, stringArg(args, "id"))
+		if err != nil {
+			return nil, err
+		}
+		return s.create(KindStackRun, Object{"stackId": stack["id"], "status": "QUEUED"}), nil
+	}
+
+	// Custom stack runs
+	s.resolvers["createCustomStackRun"] = createResolver(KindCustomStackRun)
+	s.resolvers["updateCustomStackRun"] = updateResolver(KindCustomStackRun)
+	s.resolvers["deleteCustomStackRun"] = deleteResolver(KindCustomStackRun)
+	s.resolvers["customStackRun"] = getResolver(KindCustomStackRun)
+
+	// RBAC
+	s.resolvers["updateRbac"] = func(s *Server, args map[string]any) (any, error) {
+		rbac := objectArg(args, "rbac", "attributes")
+		switch {
+		case stringArg(args, "serviceId") != "":
+			_, err := s.update(KindServiceDeployment, stringArg(args, "serviceId"), rbac)
+			return err == nil, err
+		case stringArg(args, "clusterId") != "":
+			_, err := s.update(KindCluster, stringArg(args, "clusterId"), rbac)
+			return err == nil, err
+		}
+		return true, nil
+	}
+
+	// Users, groups and service accounts
+	s.resolvers["upsertUser"] = func(s *Server, args map[string]any) (any, error) {
+		return s.upsert(KindUser, "email", objectArg(args, "attributes")), nil
+	}
+	s.resolvers["updateUser"] = updateResolver(KindUser)
+	s.resolvers["user"] = func(s *Server, args map[string]any) (any, error) {
+		return s.findBy(KindUser, "email", args["email"])
+	}
+	s.resolvers["createServiceAccount"] = func(s *Server, args map[string]any) (any, error) {
+		attrs := objectArg(args, "attributes")
+		attrs["serviceAccount"] = true
+		return s.create(KindUser, attrs), nil
+	}
+	s.resolvers["updateServiceAccount"] = updateResolver(KindUser)
+	s.resolvers["createGroup"] = createResolver(KindGroup)
+	s.resolvers["updateGroup"] = func(s *Server, args map[string]any) (any, error) {
+		return s.update(KindGroup, stringArg(args, "groupId", "id"), objectArg(args, "attributes"))
+	}
+	s.resolvers["deleteGroup"] = func(s *Server, args map[string]any) (any, error) {
+		return s.remove(KindGroup, stringArg(args, "groupId", "id"))
+	}
+	s.resolvers["group"] = func(s *Server, args map[string]any) (any, error) {
+		return s.findBy(KindGroup, "name", args["name"])
+	}
+	s.resolvers["createGroupMember"] = func(s *Server, args map[string]any) (any, error) {
+		groupID, userID := stringArg(args, "groupId"), stringArg(args, "userId")
+		if _, err := s.get(KindGroup, groupID); err != nil {
+			return nil, err
+		}
+		if _, err := s.get(KindUser, userID); err != nil {
+			return nil, err
+		}
+		if member, err := s.groupMember(groupID, userID); err == nil {
+			return member, nil
+		}
+		return s.create(KindGroupMember, Object{"groupId": groupID, "userId": userID}), nil
+	}
+	s.resolvers["deleteGroupMember"] = func(s *Server, args map[string]any) (any, error) {
+		member, err := s.groupMember(stringArg(args, "groupId"), stringArg(args, "userId"))
+		if err != nil {
+			return nil, err
+		}
+		return s.remove(KindGroupMember, member["id"].(string))
+	}
+	s.resolvers["groupMembers"] = func(s *Server, args map[string]any) (any, error) {
+		groupID := stringArg(args, "groupId")
+		if _, err := s.get(KindGroup, groupID); err != nil {
+			return nil, err
+		}
+		edges := make([]any, 0)
+		for _, member := range s.store[KindGroupMember] {
+			if member["groupId"] == groupID {
+				edges = append(edges, Object{"node": member})
+			}
+		}
+		return Object{"edges": edges, "pageInfo": Object{"hasNextPage": false}}, nil
+	}
+
+	// Triggers and secrets
+	s.resolvers["createPullRequest"] = func(s *Server, args map[string]any) (any, error) {
+		if _, err := s.get(KindPrAutomation, stringArg(args, "id")); err != nil {
+			return nil, err
+		}
+		return Object{"id": s.nextID(), "url": "https://git.example.com/pulls/1", "title": "pull request"}, nil
+	}
+	s.resolvers["prAutomation"] = func(s *Server, args map[string]any) (any, error) {
+		if id := stringArg(args, "id"); id != "" {
+			return s.get(KindPrAutomation, id)
+		}
+		return s.findBy(KindPrAutomation, "name", args["name"])
+	}
+	s.resolvers["shareSecret"] = func(s *Server, args map[string]any) (any, error) {
+		attrs := objectArg(args, "attributes")
+		return Object{"id": s.nextID(), "name": attrs["name"], "handle": s.nextID()}, nil
+	}
+
+	// OIDC providers
+	s.resolvers["createOidcProvider"] = func(s *Server, args map[string]any) (any, error) {
+		attrs := objectArg(args, "attributes")
+		attrs["type"] = args["type"]
+		provider := s.create(KindOIDCProvider, attrs)
+		provider["clientId"] = "client-" + provider["id"].(string)
+		provider["clientSecret"] = "secret-" + provider["id"].(string)
+		return provider, nil
+	}
+	s.resolvers["updateOidcProvider"] = updateResolver(KindOIDCProvider)
+	s.resolvers["deleteOidcProvider"] = deleteResolver(KindOIDCProvider)
+
+	// Webhooks
+	s.resolvers["createScmWebhookPointer"] = func(s *Server, args map[string]any) (any, error) {
+		attrs := objectArg(args, "attributes")
+		delete(attrs, "hmac")
+		webhook := s.create(KindScmWebhook, attrs)
+		webhook["name"] = webhook["owner"]
+		webhook["url"] = fmt.Sprintf("%s/ext/v1/webhooks/%s", s.URL, webhook["id"])
+		return webhook, nil
+	}
+	s.resolvers["deleteScmWebhook"] = deleteResolver(KindScmWebhook)
+	s.resolvers["scmWebhook"] = getResolver(KindScmWebhook)
+	s.resolvers["upsertObservabilityWebhook"] = func(s *Server, args map[string]any) (any, error) {
+		attrs := objectArg(args, "attributes")
+		delete(attrs, "secret")
+		webhook := s.upsert(KindObservabilityWebhook, "name", attrs)
+		webhook["url"] = fmt.Sprintf("%s/ext/v1/webhooks/observability/%s", s.URL, webhook["id"])
+		return webhook, nil
+	}
+	s.resolvers["deleteObservabilityWebhook"] = deleteResolver(KindObservabilityWebhook)
+	s.resolvers["observabilityWebhook"] = func(s *Server, args map[string]any) (any, error) {
+		if id := stringArg(args, "id"); id != "" {
+			return s.get(KindObservabilityWebhook, id)
+		}
+		return s.findBy(KindObservabilityWebhook, "name", args["name"])
+	}
+
+	// Cloud connections
+	s.resolvers["upsertCloudConnection"] = func(s *Server, args map[string]any) (any, error) {
+		return s.upsert(KindCloudConnection, "name", objectArg(args, "attributes")), nil
+	}
+	s.resolvers["deleteCloudConnection"] = deleteResolver(KindCloudConnection)
+	s.resolvers["cloudConnection"] = func(s *Server, args map[string]any) (any, error) {
+		if id := stringArg(args, "id"); id != "" {
+			return s.get(KindCloudConnection, id)
+		}
+		return s.findBy(KindCloudConnection, "name", args["name"])
+	}
+
+	// Workbenches
+	s.resolvers["agentRuntime"] = func(s *Server, args map[string]any) (any, error) {
+		if id := stringArg(args, "id"); id != "" {
+			return s.get(KindAgentRuntime, id)
+		}
+		return s.find(KindAgentRuntime, func(obj Object) bool {
+			cluster, _ := obj["cluster"].(Object)
+			return obj["name"] == args["name"] && (args["clusterId"] == nil || cluster["id"] == args["clusterId"])
+		})
+	}
+	s.resolvers["createWorkbench"] = func(s *Server, args map[string]any) (any, error) {
+		return s.create(KindWorkbench, s.workbenchAttributes(objectArg(args, "attributes"))), nil
+	}
+	s.resolvers["updateWorkbench"] = func(s *Server, args map[string]any) (any, error) {
+		return s.update(KindWorkbench, stringArg(args, "id"), s.workbenchAttributes(objectArg(args, "attributes")))
+	}
+	s.resolvers["deleteWorkbench"] = deleteResolver(KindWorkbench)
+	s.resolvers["workbench"] = getResolver(KindWorkbench)
+	s.resolvers["createWorkbenchTool"] = createResolver(KindWorkbenchTool)
+	s.resolvers["updateWorkbenchTool"] = updateResolver(KindWorkbenchTool)
+	s.resolvers["deleteWorkbenchTool"] = deleteResolver(KindWorkbenchTool)
+	s.resolvers["workbenchTool"] = getResolver(KindWorkbenchTool)
+	s.resolvers["createWorkbenchCron"] = workbenchChildResolver(KindWorkbenchCron)
+	s.resolvers["updateWorkbenchCron"] = updateResolver(KindWorkbenchCron)
+	s.resolvers["deleteWorkbenchCron"] = deleteResolver(KindWorkbenchCron)
+	s.resolvers["workbenchCron"] = getResolver(KindWorkbenchCron)
+	s.resolvers["createWorkbenchWebhook"] = workbenchChildResolver(KindWorkbenchWebhook)
+	s.resolvers["updateWorkbenchWebhook"] = updateResolver(KindWorkbenchWebhook)
+	s.resolvers["deleteWorkbenchWebhook"] = deleteResolver(KindWorkbenchWebhook)
+	s.resolvers["workbenchWebhook"] = getResolver(KindWorkbenchWebhook)
+	s.resolvers["getWorkbenchWebhook"] = getResolver(KindWorkbenchWebhook)
+}
+
+func createResolver(kind string) Resolver {
+	return func(s *Server, args map[string]any) (any, error) {
+		return s.create(kind, objectArg(args, "attributes")), nil
+	}
+}
+
+func updateResolver(kind string) Resolver {
+	return func(s *Server, args map[string]any) (any, error) {
+		return s.update(kind, stringArg(args, "id"), objectArg(args, "attributes"))
+	}
+}
+
+func getResolver(kind string) Resolver {
+	return func(s *Server, args map[string]any) (any, error) {
+		return s.get(kind, stringArg(args, "id"))
+	}
+}
+
+func deleteResolver(kind string) Resolver {
+	return func(s *Server, args map[string]any) (any, error) {
+		return s.remove(kind, stringArg(args, "id"))
+	}
+}
+
+func workbenchChildResolver(kind string) Resolver {
+	return func(s *Server, args map[string]any) (any, error) {
+		workbenchID := stringArg(args, "workbenchId")
+		if _, err := s.get(KindWorkbench, workbenchID); err != nil {
+			return nil, err
+		}
+
+		attrs := objectArg(args, "attributes")
+		attrs["workbenchId"] = workbenchID
+		return s.create(kind, attrs), nil
+	}
+}
+
+// clusterOf finds the cluster referenced by either "clusterId" or "cluster" handle arguments.
+func (s *Server) clusterOf(args map[string]any) (Object, error) {
+	if id := stringArg(args, "clusterId"); id != "" {
+		return s.get(KindCluster, id)
+	}
+
+	return s.findBy(KindCluster, "handle", args["cluster"])
+}
+
+// serviceOf finds the service referenced by either "id" or "cluster" handle and "name" arguments.
+func (s *Server) serviceOf(args map[string]any) (Object, error) {
+	if id := stringArg(args, "id"); id != "" {
+		return s.get(KindServiceDeployment, id)
+	}
+
+	cluster, err := s.findBy(KindCluster, "handle", args["cluster"])
+	if err != nil {
+		return nil, err
+	}
+
+	return s.find(KindServiceDeployment, func(obj Object) bool {
+		return obj["clusterId"] == cluster["id"] && obj["name"] == args["name"]
+	})
+}
+
+// bindContexts exposes service contexts referenced by context bindings of the service.
+func (s *Server) bindContexts(service Object) {
+	bindings, ok := service["contextBindings"].([]any)
+	if !ok {
+		return
+	}
+
+	contexts := make([]any, 0, len(bindings))
+	for _, binding := range bindings {
+		id := stringArg(binding.(Object), "contextId")
+		if context, err := s.get(KindServiceContext, id); err == nil {
+			contexts = append(contexts, context)
+		}
+	}
+	service["contexts"] = contexts
+}
+
+func (s *Server) groupMember(groupID, userID string) (Object, error) {
+	return s.find(KindGroupMember, func(obj Object) bool {
+		return obj["groupId"] == groupID && obj["userId"] == userID
+	})
+}
+
+// workbenchAttributes resolves tool associations into tools.
+func (s *Server) workbenchAttributes(attrs Object) Object {
+	associations, ok := attrs["toolAssociations"].([]any)
+	if !ok {
+		return attrs
+	}
+
+	tools := make([]any, 0, len(associations))
+	for _, association := range associations {
+		id, _ := association.(map[string]any)["toolId"].(string)
+		if tool, err := s.get(KindWorkbenchTool, id); err == nil {
+			tools = append(tools, tool)
+		} else {
+			tools = append(tools, Object{"id": id})
+		}
+	}
+	attrs["tools"] = tools
+
+	return attrs
+}
+
+// decodeNodePools decodes JSON-encoded labels of cluster node pools and identifies them by name.
+func decodeNodePools(attrs Object) Object {
+	nodePools, ok := attrs["nodePools"].([]any)
+	if !ok {
+		return attrs
+	}
+
+	for _, nodePool := range nodePools {
+		if nodePool, ok := nodePool.(map[string]any); ok {
+			decodeJSONFields(nodePool, "labels")
+			nodePool["id"] = nodePool["name"]
+		}
+	}
+
+	return attrs
+}
+
+// decodeJSONFields replaces JSON-encoded string fields with their decoded values.
+// Invalid values are left as they are.
+func decodeJSONFields(attrs Object, fields ...string) Object {
+	for _, field := range fields {
+		raw, ok := attrs[field].(string)
+		if !ok {
+			continue
+		}
+
+		var decoded any
+		if err := json.Unmarshal([]byte(raw), &decoded); err == nil {
+			attrs[field] = decoded
+		}
+	}
+
+	return attrs
+}
@@ -0,0 +1,228 @@
+// Package fakeconsole implements an in-process fake of the Plural Console GraphQL API.
+//
+// The server keeps its state in memory and resolves every request by dispatching the
+// root fields of the incoming operation to registered resolvers. Results are projected
+// onto the selection set of the query sent by the generated client, so responses always
+// have exactly the shape the client expects, no matter which fragments it uses.
+package fakeconsole
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// ErrorNotFound is the message returned by the Console API when a resource is missing.
+const ErrorNotFound = "could not find resource"
+
+// Object is a single stored entity, keyed by GraphQL field names.
+type Object = map[string]any
+
+// Resolver resolves a single root field using its arguments.
+type Resolver func(s *Server, args map[string]any) (any, error)
+
+// Server is a fake Console API backed by in-memory state.
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	store     map[string]map[string]Object
+	sequence  int
+	resolvers map[string]Resolver
+	requests  []string
+}
+
+type request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+type response struct {
+	Data   map[string]any `json:"data,omitempty"`
+	Errors gqlerror.List  `json:"errors,omitempty"`
+}
+
+// New starts a new fake Console server with the default set of resolvers.
+// The caller is responsible for closing it.
+func New() *Server {
+	s := &Server{
+		store:     map[string]map[string]Object{},
+		resolvers: map[string]Resolver{},
+	}
+	registerResolvers(s)
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// Handle registers a resolver for the given root field, replacing any existing one.
+func (s *Server) Handle(field string, resolver Resolver) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.resolvers[field] = resolver
+}
+
+// Remove unregisters the resolver for the given root field, i.e. to simulate an older Console version.
+func (s *Server) Remove(field string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.resolvers, field)
+}
+
+// Requests returns names of all operations received by the server so far.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string{}, s.requests...)
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	req := new(request)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(s.execute(req))
+}
+
+func (s *Server) execute(req *request) *response {
+	doc, err := parser.ParseQuery(&ast.Source{Input: req.Query})
+	if err != nil {
+		return &response{Errors: gqlerror.List{gqlerror.Errorf("invalid query: %s", err.Error())}}
+	}
+
+	operation := doc.Operations.ForName(req.OperationName)
+	if operation == nil && len(doc.Operations) > 0 {
+		operation = doc.Operations[0]
+	}
+	if operation == nil {
+		return &response{Errors: gqlerror.List{gqlerror.Errorf("no operation found in query")}}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, operation.Name)
+
+	result := &response{Data: map[string]any{}}
+	for _, field := range collectFields(doc, operation.SelectionSet) {
+		key := responseKey(field)
+		resolver, ok := s.resolvers[field.Name]
+		if !ok {
+			result.Errors = append(result.Errors, gqlerror.ErrorPathf(ast.Path{ast.PathName(key)}, "fake console does not support field %q", field.Name))
+			result.Data[key] = nil
+			continue
+		}
+
+		args, err := argumentsOf(field, req.Variables)
+		if err != nil {
+			result.Errors = append(result.Errors, gqlerror.ErrorPathf(ast.Path{ast.PathName(key)}, "%s", err.Error()))
+			result.Data[key] = nil
+			continue
+		}
+
+		value, err := resolver(s, args)
+		if err != nil {
+			result.Errors = append(result.Errors, gqlerror.ErrorPathf(ast.Path{ast.PathName(key)}, "%s", err.Error()))
+			result.Data[key] = nil
+			continue
+		}
+
+		result.Data[key] = project(doc, field.SelectionSet, value)
+	}
+
+	if len(result.Errors) > 0 {
+		result.Data = nil
+	}
+
+	return result
+}
+
+func argumentsOf(field *ast.Field, vars map[string]any) (map[string]any, error) {
+	args := make(map[string]any, len(field.Arguments))
+	for _, arg := range field.Arguments {
+		value, err := arg.Value.Value(vars)
+		if err != nil {
+			return nil, fmt.Errorf("invalid argument %q: %w", arg.Name, err)
+		}
+		args[arg.Name] = value
+	}
+
+	return args, nil
+}
+
+func responseKey(field *ast.Field) string {
+	if field.Alias != "" {
+		return field.Alias
+	}
+
+	return field.Name
+}
+
+// collectFields flattens fragment spreads and inline fragments into a list of fields.
+// Type conditions are ignored, every fragment is applied to every object.
+func collectFields(doc *ast.QueryDocument, set ast.SelectionSet) []*ast.Field {
+	fields := make([]*ast.Field, 0, len(set))
+	for _, selection := range set {
+		switch s := selection.(type) {
+		case *ast.Field:
+			fields = append(fields, s)
+		case *ast.InlineFragment:
+			fields = append(fields, collectFields(doc, s.SelectionSet)...)
+		case *ast.FragmentSpread:
+			if fragment := doc.Fragments.ForName(s.Name); fragment != nil {
+				fields = append(fields, collectFields(doc, fragment.SelectionSet)...)
+			}
+		}
+	}
+
+	return fields
+}
+
+// project returns a copy of value that contains only fields from the selection set.
+// Fields that are missing in the value are returned as null.
+func project(doc *ast.QueryDocument, set ast.SelectionSet, value any) any {
+	if len(set) == 0 || value == nil {
+		return value
+	}
+
+	switch v := value.(type) {
+	case Object:
+		result := Object{}
+		for _, field := range collectFields(doc, set) {
+			result[responseKey(field)] = project(doc, field.SelectionSet, v[field.Name])
+		}
+		return result
+	case []Object:
+		result := make([]any, 0, len(v))
+		for _, elem := range v {
+			result = append(result, project(doc, set, elem))
+		}
+		return result
+	case []any:
+		result := make([]any, 0, len(v))
+		for _, elem := range v {
+			result = append(result, project(doc, set, elem))
+		}
+		return result
+	default:
+		// Scalar stored where an object was requested.
+		return nil
+	}
+}
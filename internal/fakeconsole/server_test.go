@@ -0,0 +1,120 @@
+package fakeconsole
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func post(t *testing.T, s *Server, operation, query string, vars map[string]any) map[string]any {
+	t.Helper()
+
+	body, err := json.Marshal(request{Query: query, OperationName: operation, Variables: vars})
+	if err != nil {
+		t.Fatalf("failed to marshal request: %v", err)
+	}
+
+	resp, err := http.Post(s.URL+"/gql", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	result := map[string]any{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	return result
+}
+
+func TestServerProjectsResultsOntoSelectionSet(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	const create = `mutation CreateCluster($attributes: ClusterAttributes!) {
+		createCluster(attributes: $attributes) { ...ClusterFragment deployToken }
+	}
+	fragment ClusterFragment on Cluster { id name handle metadata project { id name } tags { name value } }`
+
+	result := post(t, s, "CreateCluster", create, map[string]any{
+		"attributes": map[string]any{
+			"name":      "test",
+			"handle":    "test",
+			"projectId": s.Seed(KindProject, Object{"name": "default"}),
+			"metadata":  `{"key":"value"}`,
+			"tags":      []any{map[string]any{"name": "env", "value": "dev"}},
+		},
+	})
+
+	cluster := result["data"].(map[string]any)["createCluster"].(map[string]any)
+	if _, ok := cluster["insertedAt"]; ok {
+		t.Fatalf("expected unselected field to be omitted, got %v", cluster)
+	}
+	if got := cluster["project"].(map[string]any)["name"]; got != "default" {
+		t.Fatalf("expected project reference to be resolved, got %v", got)
+	}
+	if got := cluster["metadata"].(map[string]any)["key"]; got != "value" {
+		t.Fatalf("expected metadata to be decoded, got %v", got)
+	}
+	if got := cluster["deployToken"]; got == nil {
+		t.Fatal("expected deploy token to be set")
+	}
+
+	const get = `query GetClusterByHandle($handle: String) { cluster(handle: $handle) { id name } }`
+	result = post(t, s, "GetClusterByHandle", get, map[string]any{"handle": "test"})
+	if got := result["data"].(map[string]any)["cluster"].(map[string]any)["id"]; got != cluster["id"] {
+		t.Fatalf("expected cluster %v, got %v", cluster["id"], got)
+	}
+}
+
+func TestServerReturnsNotFoundErrors(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	result := post(t, s, "GetCluster", `query GetCluster($id: ID) { cluster(id: $id) { id } }`, map[string]any{"id": "missing"})
+
+	errors, ok := result["errors"].([]any)
+	if !ok || len(errors) != 1 {
+		t.Fatalf("expected a single error, got %v", result)
+	}
+	if got := errors[0].(map[string]any)["message"]; got != ErrorNotFound {
+		t.Fatalf("expected not found error, got %v", got)
+	}
+}
+
+func TestServerRejectsUnsupportedFields(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	result := post(t, s, "Unknown", `query Unknown { unknownField { id } }`, nil)
+	if _, ok := result["errors"]; !ok {
+		t.Fatalf("expected an error, got %v", result)
+	}
+	if got := s.Requests(); len(got) != 1 || got[0] != "Unknown" {
+		t.Fatalf("expected request to be recorded, got %v", got)
+	}
+}
+
+func TestServerReportsSupportedFields(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	s.Remove("createWorkbench")
+
+	const introspection = `query GetConsoleSchema { __schema { mutationType { fields { name } } } }`
+	result := post(t, s, "GetConsoleSchema", introspection, nil)
+
+	fields := result["data"].(map[string]any)["__schema"].(map[string]any)["mutationType"].(map[string]any)["fields"].([]any)
+	names := map[any]bool{}
+	for _, field := range fields {
+		names[field.(map[string]any)["name"]] = true
+	}
+	if !names["createCluster"] {
+		t.Fatalf("expected createCluster to be reported, got %v", fields)
+	}
+	if names["createWorkbench"] {
+		t.Fatalf("expected removed createWorkbench not to be reported, got %v", fields)
+	}
+}
@@ -0,0 +1,270 @@
+package fakeconsole
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Kinds of objects stored by the fake server.
+const (
+	KindAgentRuntime         = "agentRuntime"
+	KindCloudConnection      = "cloudConnection"
+	KindCluster              = "cluster"
+	KindConfiguration        = "configuration"
+	KindCustomStackRun       = "customStackRun"
+	KindDeploymentSettings   = "deploymentSettings"
+	KindGitRepository        = "gitRepository"
+	KindGlobalService        = "globalService"
+	KindGroup                = "group"
+	KindGroupMember          = "groupMember"
+	KindInfrastructureStack  = "infrastructureStack"
+	KindObservabilityWebhook = "observabilityWebhook"
+	KindOIDCProvider         = "oidcProvider"
+	KindPrAutomation         = "prAutomation"
+	KindProject              = "project"
+	KindScmWebhook           = "scmWebhook"
+	KindServiceContext       = "serviceContext"
+	KindServiceDeployment    = "serviceDeployment"
+	KindStackRun             = "stackRun"
+	KindUser                 = "user"
+	KindWorkbench            = "workbench"
+	KindWorkbenchCron        = "workbenchCron"
+	KindWorkbenchTool        = "workbenchTool"
+	KindWorkbenchWebhook     = "workbenchWebhook"
+)
+
+// references maps names of reference fields, i.e. "projectId", to kinds of objects they point to.
+var references = map[string]string{
+	"cluster":      KindCluster,
+	"project":      KindProject,
+	"repository":   KindGitRepository,
+	"service":      KindServiceDeployment,
+	"stack":        KindInfrastructureStack,
+	"user":         KindUser,
+	"group":        KindGroup,
+	"workbench":    KindWorkbench,
+	"webhook":      KindObservabilityWebhook,
+	"agentRuntime": KindAgentRuntime,
+}
+
+var errNotFound = errors.New(ErrorNotFound)
+
+// Seed stores a copy of the object under the given kind and returns its ID.
+// A new ID is generated if the object does not have one.
+func (s *Server) Seed(kind string, obj Object) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.put(kind, s.normalize(obj))["id"].(string)
+}
+
+// Get returns a copy of the stored object or nil if it does not exist.
+func (s *Server) Get(kind, id string) Object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj, ok := s.store[kind][id]
+	if !ok {
+		return nil
+	}
+
+	return copyObject(obj)
+}
+
+// Set updates fields of the stored object. It returns an error if the object does not exist.
+func (s *Server) Set(kind, id string, fields Object) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err := s.update(kind, id, fields)
+	return err
+}
+
+// Delete removes the stored object if it exists.
+func (s *Server) Delete(kind, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.store[kind], id)
+}
+
+// List returns copies of all objects of the given kind.
+func (s *Server) List(kind string) []Object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]Object, 0, len(s.store[kind]))
+	for _, obj := range s.store[kind] {
+		result = append(result, copyObject(obj))
+	}
+
+	return result
+}
+
+func (s *Server) nextID() string {
+	s.sequence++
+	return fmt.Sprintf("00000000-0000-0000-0000-%012d", s.sequence)
+}
+
+func (s *Server) put(kind string, obj Object) Object {
+	if _, ok := s.store[kind]; !ok {
+		s.store[kind] = map[string]Object{}
+	}
+
+	id, _ := obj["id"].(string)
+	if id == "" {
+		id = s.nextID()
+		obj["id"] = id
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	if _, ok := obj["insertedAt"]; !ok {
+		obj["insertedAt"] = now
+	}
+	obj["updatedAt"] = now
+
+	s.store[kind][id] = obj
+	return obj
+}
+
+func (s *Server) create(kind string, attrs Object) Object {
+	obj := s.normalize(attrs)
+	delete(obj, "id")
+	return s.put(kind, obj)
+}
+
+func (s *Server) get(kind, id string) (Object, error) {
+	obj, ok := s.store[kind][id]
+	if !ok {
+		return nil, errNotFound
+	}
+
+	return obj, nil
+}
+
+func (s *Server) find(kind string, matches func(Object) bool) (Object, error) {
+	for _, obj := range s.store[kind] {
+		if matches(obj) {
+			return obj, nil
+		}
+	}
+
+	return nil, errNotFound
+}
+
+func (s *Server) findBy(kind, field string, value any) (Object, error) {
+	return s.find(kind, func(obj Object) bool { return value != nil && obj[field] == value })
+}
+
+func (s *Server) update(kind, id string, attrs Object) (Object, error) {
+	obj, err := s.get(kind, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range s.normalize(attrs) {
+		if k == "id" {
+			continue
+		}
+		obj[k] = v
+	}
+	obj["updatedAt"] = time.Now().UTC().Format(time.RFC3339)
+
+	return obj, nil
+}
+
+func (s *Server) upsert(kind, field string, attrs Object) Object {
+	if obj, err := s.findBy(kind, field, attrs[field]); err == nil {
+		obj, _ = s.update(kind, obj["id"].(string), attrs)
+		return obj
+	}
+
+	return s.create(kind, attrs)
+}
+
+func (s *Server) remove(kind, id string) (Object, error) {
+	obj, err := s.get(kind, id)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.store[kind], id)
+	return obj, nil
+}
+
+// normalize converts input attributes into the shape of stored objects. Every reference
+// field, i.e. "projectId", is additionally exposed as an object, i.e. "project", that
+// points to the referenced object if it exists.
+func (s *Server) normalize(attrs Object) Object {
+	result := Object{}
+	for k, v := range attrs {
+		result[k] = s.normalizeValue(v)
+
+		name, isRef := strings.CutSuffix(k, "Id")
+		id, isString := v.(string)
+		if !isRef || !isString || name == "" {
+			continue
+		}
+
+		if _, exists := attrs[name]; exists {
+			continue
+		}
+
+		if kind, ok := references[name]; ok {
+			if ref, err := s.get(kind, id); err == nil {
+				result[name] = ref
+				continue
+			}
+		}
+
+		result[name] = Object{"id": id}
+	}
+
+	return result
+}
+
+func (s *Server) normalizeValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		return s.normalize(v)
+	case []any:
+		result := make([]any, 0, len(v))
+		for _, elem := range v {
+			result = append(result, s.normalizeValue(elem))
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+func copyObject(obj Object) Object {
+	result := make(Object, len(obj))
+	for k, v := range obj {
+		result[k] = v
+	}
+
+	return result
+}
+
+func stringArg(args map[string]any, names ...string) string {
+	for _, name := range names {
+		if v, ok := args[name].(string); ok && v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
+
+func objectArg(args map[string]any, names ...string) Object {
+	for _, name := range names {
+		if v, ok := args[name].(map[string]any); ok {
+			return v
+		}
+	}
+
+	return Object{}
+}
@@ -11,8 +11,9 @@ import (
 )
 
 type Config struct {
-	Email types.String `tfsdk:"email" yaml:"email"`
-	Token types.String `tfsdk:"token" yaml:"token"`
+	Email          types.String `tfsdk:"email" yaml:"email"`
+	Token          types.String `tfsdk:"token" yaml:"token"`
+	ConsoleVersion types.String `tfsdk:"console_version" yaml:"-"`
 }
 
 type LocalConfig struct {
@@ -6,6 +6,7 @@ import (
 	"terraform-provider-plural/internal/client"
 	"terraform-provider-plural/internal/common"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -42,6 +43,7 @@ type InfrastructureStackExtended struct {
 	Environment   types.Set                         `tfsdk:"environment"`
 	JobSpec       *InfrastructureStackJobSpec       `tfsdk:"job_spec"`
 	Bindings      *common.Bindings                  `tfsdk:"bindings"`
+	Timeouts      timeouts.Value                    `tfsdk:"timeouts"`
 }
 
 func (is *InfrastructureStackExtended) Attributes(ctx context.Context, d *diag.Diagnostics, client *client.Client) (*gqlclient.StackAttributes, error) {
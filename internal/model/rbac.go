@@ -3,6 +3,7 @@ package model
 import (
 	"context"
 
+	"terraform-provider-plural/internal/client"
 	"terraform-provider-plural/internal/common"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -11,9 +12,10 @@ import (
 )
 
 type RBAC struct {
-	ClusterId types.String     `tfsdk:"cluster_id"`
-	ServiceId types.String     `tfsdk:"service_id"`
-	Bindings  *common.Bindings `tfsdk:"bindings"`
+	ClusterId        types.String     `tfsdk:"cluster_id"`
+	ServiceId        types.String     `tfsdk:"service_id"`
+	Bindings         *common.Bindings `tfsdk:"bindings"`
+	BaselineBindings *common.Bindings `tfsdk:"baseline_bindings"`
 }
 
 func (rbac *RBAC) Attributes(ctx context.Context, d *diag.Diagnostics) gqlclient.RbacAttributes {
@@ -22,3 +24,18 @@ func (rbac *RBAC) Attributes(ctx context.Context, d *diag.Diagnostics) gqlclient
 		WriteBindings: rbac.Bindings.WriteAttributes(ctx, d),
 	}
 }
+
+func (rbac *RBAC) From(bindings *client.Bindings, ctx context.Context, d *diag.Diagnostics) {
+	if rbac.Bindings == nil {
+		if len(bindings.ReadBindings) == 0 && len(bindings.WriteBindings) == 0 {
+			return
+		}
+
+		rbac.Bindings = &common.Bindings{
+			Read:  types.SetNull(types.ObjectType{AttrTypes: common.PolicyBindingAttrTypes}),
+			Write: types.SetNull(types.ObjectType{AttrTypes: common.PolicyBindingAttrTypes}),
+		}
+	}
+
+	rbac.Bindings.FromPrior(bindings.ReadBindings, bindings.WriteBindings, ctx, d)
+}
@@ -2,9 +2,14 @@ package model
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
 
+	"terraform-provider-plural/internal/client"
 	"terraform-provider-plural/internal/common"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -12,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	gqlclient "github.com/pluralsh/console/go/client"
 	"github.com/pluralsh/polly/algorithms"
+	"github.com/samber/lo"
 )
 
 type ServiceDeployment struct {
@@ -24,11 +30,17 @@ type ServiceDeployment struct {
 	Templated     types.Bool                   `tfsdk:"templated"`
 	Kustomize     *ServiceDeploymentKustomize  `tfsdk:"kustomize"`
 	Configuration types.Map                    `tfsdk:"configuration"`
+	Secrets       types.Map                    `tfsdk:"secrets"`
+	SecretHashes  types.Map                    `tfsdk:"secret_hashes"`
+	Dependencies  types.Set                    `tfsdk:"dependencies"`
+	Contexts      types.Set                    `tfsdk:"contexts"`
 	Cluster       *ServiceDeploymentCluster    `tfsdk:"cluster"`
 	Repository    *ServiceDeploymentRepository `tfsdk:"repository"`
 	Bindings      *common.Bindings             `tfsdk:"bindings"`
 	SyncConfig    *ServiceDeploymentSyncConfig `tfsdk:"sync_config"`
 	Helm          *ServiceDeploymentHelm       `tfsdk:"helm"`
+	WaitFor       *ServiceDeploymentWaitFor    `tfsdk:"wait_for"`
+	Timeouts      timeouts.Value               `tfsdk:"timeouts"`
 }
 
 func (sd *ServiceDeployment) VersionString() *string {
@@ -47,21 +59,38 @@ func (sd *ServiceDeployment) FromCreate(response *gqlclient.ServiceDeploymentExt
 	sd.Protect = types.BoolPointerValue(response.Protect)
 	sd.Version = types.StringValue(response.Version)
 	sd.Kustomize.From(response.Kustomize)
-	sd.Configuration = configFrom(response.Configuration, d)
+	sd.Configuration = configFrom(sd.withoutSecrets(response.Configuration), d)
 	sd.Cluster.From(response.Cluster)
 	sd.Repository.From(response.Repository, response.Git)
 	sd.Templated = types.BoolPointerValue(response.Templated)
 }
 
-func (sd *ServiceDeployment) FromGet(response *gqlclient.ServiceDeploymentExtended, d *diag.Diagnostics) {
+func (sd *ServiceDeployment) FromGet(response *gqlclient.ServiceDeploymentExtended, relations *client.ServiceDeploymentRelations, ctx context.Context, d *diag.Diagnostics) {
+	// Imported services have no prior secret hashes, so configuration cannot be told apart from secrets.
+	// All values are treated as secrets then to keep plaintext out of the state until the next apply.
+	if sd.Name.IsNull() {
+		sd.SecretHashes = configurationHashes(response.Configuration, func(string) bool { return true }, d)
+	}
+
 	sd.Id = types.StringValue(response.ID)
 	sd.Name = types.StringValue(response.Name)
 	sd.Namespace = types.StringValue(response.Namespace)
 	sd.Protect = types.BoolPointerValue(response.Protect)
 	sd.Kustomize.From(response.Kustomize)
-	sd.Configuration = configFrom(response.Configuration, d)
+	sd.Configuration = configFrom(sd.withoutSecrets(response.Configuration), d)
+	sd.SecretHashes = sd.secretHashesFrom(response.Configuration, d)
 	sd.Repository.From(response.Repository, response.Git)
 	sd.Templated = types.BoolPointerValue(response.Templated)
+
+	if response.Cluster != nil {
+		if sd.Cluster == nil {
+			// Cluster is not known yet when the resource is being imported.
+			sd.Cluster = &ServiceDeploymentCluster{}
+		}
+		sd.Cluster.From(response.Cluster)
+	}
+
+	sd.relationsFrom(relations, ctx, d)
 }
 
 func (sd *ServiceDeployment) Attributes(ctx context.Context, d *diag.Diagnostics) gqlclient.ServiceDeploymentAttributes {
@@ -85,6 +114,7 @@ func (sd *ServiceDeployment) Attributes(ctx context.Context, d *diag.Diagnostics
 		Git:           sd.Repository.Attributes(),
 		Kustomize:     sd.Kustomize.Attributes(),
 		Configuration: sd.ToServiceDeploymentConfigAttributes(ctx, d),
+		Dependencies:  sd.DependenciesAttributes(ctx, d),
 		ReadBindings:  sd.Bindings.ReadAttributes(ctx, d),
 		WriteBindings: sd.Bindings.WriteAttributes(ctx, d),
 		Helm:          sd.Helm.Attributes(),
@@ -102,12 +132,88 @@ func (sd *ServiceDeployment) UpdateAttributes(ctx context.Context, d *diag.Diagn
 		Protect:       sd.Protect.ValueBoolPointer(),
 		Git:           sd.Repository.Attributes(),
 		Configuration: sd.ToServiceDeploymentConfigAttributes(ctx, d),
+		Dependencies:  sd.DependenciesAttributes(ctx, d),
 		Kustomize:     sd.Kustomize.Attributes(),
 		Helm:          sd.Helm.Attributes(),
 		Templated:     sd.Templated.ValueBoolPointer(),
 	}
 }
 
+// RemovedRelations checks if dependencies or contexts were removed from the config or set to an empty set while
+// the prior state still has them. Such relations have to be cleared explicitly, as the Console ignores null
+// and empty values on update.
+func (sd *ServiceDeployment) RemovedRelations(prior *ServiceDeployment) (dependencies, contexts bool) {
+	removed := func(planned, prior types.Set) bool {
+		empty := planned.IsNull() || (!planned.IsUnknown() && len(planned.Elements()) == 0)
+		return empty && !prior.IsNull() && !prior.IsUnknown() && len(prior.Elements()) > 0
+	}
+
+	return removed(sd.Dependencies, prior.Dependencies), removed(sd.Contexts, prior.Contexts)
+}
+
+func (sd *ServiceDeployment) DependenciesAttributes(ctx context.Context, d *diag.Diagnostics) []*gqlclient.ServiceDependencyAttributes {
+	if sd.Dependencies.IsNull() || sd.Dependencies.IsUnknown() {
+		return nil
+	}
+
+	dependencies := make([]string, 0, len(sd.Dependencies.Elements()))
+	d.Append(sd.Dependencies.ElementsAs(ctx, &dependencies, false)...)
+
+	return algorithms.Map(dependencies, func(name string) *gqlclient.ServiceDependencyAttributes {
+		return &gqlclient.ServiceDependencyAttributes{Name: name}
+	})
+}
+
+// relationsFrom rebuilds dependencies and contexts from the ones attached to the service. Contexts are
+// kept in the form used in the prior state, that is either by ID or by name.
+func (sd *ServiceDeployment) relationsFrom(relations *client.ServiceDeploymentRelations, ctx context.Context, d *diag.Diagnostics) {
+	if relations == nil {
+		relations = &client.ServiceDeploymentRelations{}
+	}
+
+	dependencies := make([]string, 0, len(relations.Dependencies))
+	for _, dependency := range relations.Dependencies {
+		if dependency != nil {
+			dependencies = append(dependencies, dependency.Name)
+		}
+	}
+	sd.Dependencies = setFrom(dependencies, sd.Dependencies, ctx, d)
+
+	priorContexts := make([]string, 0)
+	if !sd.Contexts.IsNull() && !sd.Contexts.IsUnknown() {
+		d.Append(sd.Contexts.ElementsAs(ctx, &priorContexts, false)...)
+	}
+
+	contexts := make([]string, 0, len(relations.Contexts))
+	for _, c := range relations.Contexts {
+		if c == nil {
+			continue
+		}
+
+		if lo.Contains(priorContexts, c.ID) {
+			contexts = append(contexts, c.ID)
+		} else {
+			contexts = append(contexts, c.Name)
+		}
+	}
+	sd.Contexts = setFrom(contexts, sd.Contexts, ctx, d)
+}
+
+func setFrom(values []string, prior types.Set, ctx context.Context, d *diag.Diagnostics) types.Set {
+	if len(values) == 0 {
+		if !prior.IsNull() && !prior.IsUnknown() && len(prior.Elements()) == 0 {
+			// Keep empty set from the config to avoid inconsistent result errors.
+			return prior
+		}
+
+		return types.SetNull(types.StringType)
+	}
+
+	result, diags := types.SetValueFrom(ctx, types.StringType, values)
+	d.Append(diags...)
+	return result
+}
+
 type ServiceDeploymentConfiguration struct {
 	Name  types.String `tfsdk:"name"`
 	Value types.String `tfsdk:"value"`
@@ -130,21 +236,134 @@ func configFrom(configuration []*gqlclient.ServiceDeploymentExtended_Configurati
 }
 
 func (sd *ServiceDeployment) ToServiceDeploymentConfigAttributes(ctx context.Context, d *diag.Diagnostics) []*gqlclient.ConfigAttributes {
-	if sd.Configuration.IsNull() || sd.Configuration.IsUnknown() {
+	configuration := mapElements(sd.Configuration, ctx, d)
+	secrets := mapElements(sd.Secrets, ctx, d)
+	if configuration == nil && secrets == nil {
 		return nil
 	}
 
-	result := make([]*gqlclient.ConfigAttributes, 0)
-	elements := make(map[string]types.String, len(sd.Configuration.Elements()))
-	d.Append(sd.Configuration.ElementsAs(ctx, &elements, false)...)
+	result := make([]*gqlclient.ConfigAttributes, 0, len(configuration)+len(secrets))
+	for k, v := range configuration {
+		result = append(result, &gqlclient.ConfigAttributes{Name: k, Value: v.ValueStringPointer()})
+	}
 
-	for k, v := range elements {
+	// Secrets are sent as regular configuration, they are only kept out of the state.
+	for k, v := range secrets {
 		result = append(result, &gqlclient.ConfigAttributes{Name: k, Value: v.ValueStringPointer()})
 	}
 
 	return result
 }
 
+func mapElements(m types.Map, ctx context.Context, d *diag.Diagnostics) map[string]types.String {
+	if m.IsNull() || m.IsUnknown() {
+		return nil
+	}
+
+	elements := make(map[string]types.String, len(m.Elements()))
+	d.Append(m.ElementsAs(ctx, &elements, false)...)
+	return elements
+}
+
+// SecretHashFrom returns SHA-256 hash of a write-only secret, so that its changes can be detected without keeping
+// the plaintext value in the state.
+func SecretHashFrom(secret types.String) types.String {
+	if secret.IsUnknown() {
+		return types.StringUnknown()
+	}
+
+	if secret.IsNull() {
+		return types.StringNull()
+	}
+
+	return types.StringValue(secretHash(secret.ValueString()))
+}
+
+// SecretHashesFrom returns SHA-256 hashes of secret values keyed by secret names.
+// Hashes are stored in the state instead of secrets to detect changes without keeping plaintext values.
+func SecretHashesFrom(secrets types.Map, ctx context.Context, d *diag.Diagnostics) types.Map {
+	if secrets.IsUnknown() {
+		return types.MapUnknown(types.StringType)
+	}
+
+	elements := mapElements(secrets, ctx, d)
+	if len(elements) == 0 {
+		return types.MapNull(types.StringType)
+	}
+
+	hashes := make(map[string]attr.Value, len(elements))
+	for k, v := range elements {
+		if v.IsUnknown() {
+			return types.MapUnknown(types.StringType)
+		}
+
+		hashes[k] = types.StringValue(secretHash(v.ValueString()))
+	}
+
+	result, diags := types.MapValue(types.StringType, hashes)
+	d.Append(diags...)
+	return result
+}
+
+func secretHash(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+func (sd *ServiceDeployment) isSecret(name string) bool {
+	if sd.SecretHashes.IsNull() || sd.SecretHashes.IsUnknown() {
+		return false
+	}
+
+	_, ok := sd.SecretHashes.Elements()[name]
+	return ok
+}
+
+// withoutSecrets filters out secrets from the configuration returned by the API.
+func (sd *ServiceDeployment) withoutSecrets(configuration []*gqlclient.ServiceDeploymentExtended_Configuration) []*gqlclient.ServiceDeploymentExtended_Configuration {
+	return algorithms.Filter(configuration, func(c *gqlclient.ServiceDeploymentExtended_Configuration) bool {
+		return c != nil && !sd.isSecret(c.Name)
+	})
+}
+
+// secretHashesFrom recalculates hashes of known secrets using the configuration returned by the API,
+// so that secrets changed or removed outside of Terraform are detected.
+func (sd *ServiceDeployment) secretHashesFrom(configuration []*gqlclient.ServiceDeploymentExtended_Configuration, d *diag.Diagnostics) types.Map {
+	return configurationHashes(configuration, sd.isSecret, d)
+}
+
+// configurationHashes returns hashes of configuration values with names accepted by the filter.
+func configurationHashes(configuration []*gqlclient.ServiceDeploymentExtended_Configuration, filter func(name string) bool, d *diag.Diagnostics) types.Map {
+	hashes := make(map[string]attr.Value)
+	for _, c := range configuration {
+		if c != nil && filter(c.Name) {
+			hashes[c.Name] = types.StringValue(secretHash(c.Value))
+		}
+	}
+
+	if len(hashes) == 0 {
+		return types.MapNull(types.StringType)
+	}
+
+	result, diags := types.MapValue(types.StringType, hashes)
+	d.Append(diags...)
+	return result
+}
+
+type ServiceDeploymentWaitFor struct {
+	Status       types.String `tfsdk:"status"`
+	Timeout      types.String `tfsdk:"timeout"`
+	PollInterval types.String `tfsdk:"poll_interval"`
+}
+
+func (wf *ServiceDeploymentWaitFor) ParseTimeout() (time.Duration, error) {
+	return time.ParseDuration(wf.Timeout.ValueString())
+}
+
+func (wf *ServiceDeploymentWaitFor) ParsePollInterval() (time.Duration, error) {
+	return time.ParseDuration(wf.PollInterval.ValueString())
+}
+
 type ServiceDeploymentCluster struct {
 	Id     types.String `tfsdk:"id"`
 	Handle types.String `tfsdk:"handle"`
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-plural/internal/fakeconsole"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudConnectionResource(t *testing.T) {
+	console := testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(console, fakeconsole.KindCloudConnection),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "plural_cloud_connection" "test" {
+  name           = "test"
+  cloud_provider = "AWS"
+  configuration = {
+    aws = {
+      access_key_id     = "access"
+      secret_access_key = "secret"
+      region            = "us-east-1"
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExists(console, fakeconsole.KindCloudConnection, "plural_cloud_connection.test"),
+					resource.TestCheckResourceAttr("plural_cloud_connection.test", "cloud_provider", "AWS"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,306 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-plural/internal/fakeconsole"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccClusterResource(t *testing.T) {
+	console := testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(console, fakeconsole.KindCluster),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "plural_cluster" "test" {
+  name     = "test"
+  handle   = "test"
+  metadata = jsonencode({ key = "value" })
+  tags     = { env = "dev" }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExists(console, fakeconsole.KindCluster, "plural_cluster.test"),
+					resource.TestCheckResourceAttrSet("plural_cluster.test", "id"),
+					resource.TestCheckResourceAttrSet("plural_cluster.test", "inserted_at"),
+					resource.TestCheckResourceAttr("plural_cluster.test", "handle", "test"),
+					resource.TestCheckResourceAttr("plural_cluster.test", "tags.env", "dev"),
+					resource.TestCheckResourceAttr("plural_cluster.test", "metadata", `{"key":"value"}`),
+					resource.TestCheckResourceAttr("plural_cluster.test", "agent_deployed", "false"),
+				),
+			},
+			{
+				ResourceName:            "plural_cluster.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"detach", "helm_repo_url", "agent_deployed", "wait_for_upgrade", "uninstall_agent_on_destroy", "remove_agent_namespace", "agent_wait", "detach_on_delete_timeout"},
+			},
+			{
+				ResourceName:            "plural_cluster.test",
+				ImportState:             true,
+				ImportStateId:           "@test",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"detach", "helm_repo_url", "agent_deployed", "wait_for_upgrade", "uninstall_agent_on_destroy", "remove_agent_namespace", "agent_wait", "detach_on_delete_timeout"},
+			},
+			{
+				Config: `
+resource "plural_cluster" "test" {
+  name     = "renamed"
+  handle   = "test"
+  metadata = jsonencode({ key = "updated" })
+  tags     = { env = "prod" }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("plural_cluster.test", "name", "renamed"),
+					resource.TestCheckResourceAttr("plural_cluster.test", "tags.env", "prod"),
+					resource.TestCheckResourceAttr("plural_cluster.test", "metadata", `{"key":"updated"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccClusterResourceProviderDefaults(t *testing.T) {
+	console := testAccConsole(t)
+	projectId := console.Seed(fakeconsole.KindProject, fakeconsole.Object{"name": "default"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(console, fakeconsole.KindCluster),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "plural" {
+  default_project_id = %q
+  default_tags       = { owner = "platform", env = "default" }
+}
+
+resource "plural_cluster" "test" {
+  name   = "test"
+  handle = "test"
+  tags   = { env = "dev" }
+}
+`, projectId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("plural_cluster.test", "project_id", projectId),
+					resource.TestCheckResourceAttr("plural_cluster.test", "tags.%", "1"),
+					resource.TestCheckResourceAttr("plural_cluster.test", "tags.env", "dev"),
+					resource.TestCheckResourceAttr("plural_cluster.test", "tags_all.%", "2"),
+					resource.TestCheckResourceAttr("plural_cluster.test", "tags_all.env", "dev"),
+					resource.TestCheckResourceAttr("plural_cluster.test", "tags_all.owner", "platform"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccClusterResourceNodePools(t *testing.T) {
+	console := testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(console, fakeconsole.KindCluster),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "plural_cluster" "test" {
+  name   = "test"
+  handle = "test"
+  node_pools = {
+    general = {
+      name          = "general"
+      min_size      = 1
+      max_size      = 3
+      instance_type = "t5.large"
+      labels        = { role = "general" }
+      taints        = [{ key = "dedicated", value = "general", effect = "NoSchedule" }]
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("plural_cluster.test", "node_pools.%", "1"),
+					resource.TestCheckResourceAttr("plural_cluster.test", "node_pools.general.max_size", "3"),
+					resource.TestCheckResourceAttr("plural_cluster.test", "node_pools.general.labels.role", "general"),
+					resource.TestCheckResourceAttr("plural_cluster.test", "node_pools.general.taints.#", "1"),
+				),
+			},
+			{
+				ResourceName:            "plural_cluster.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"detach", "helm_repo_url", "agent_deployed", "wait_for_upgrade", "uninstall_agent_on_destroy", "remove_agent_namespace", "agent_wait", "detach_on_delete_timeout"},
+			},
+			{
+				Config: `
+resource "plural_cluster" "test" {
+  name   = "test"
+  handle = "test"
+  node_pools = {
+    general = {
+      name          = "general"
+      min_size      = 1
+      max_size      = 5
+      instance_type = "t5.large"
+    }
+    gpu = {
+      name          = "gpu"
+      min_size      = 0
+      max_size      = 2
+      instance_type = "g5.xlarge"
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("plural_cluster.test", "node_pools.%", "2"),
+					resource.TestCheckResourceAttr("plural_cluster.test", "node_pools.general.max_size", "5"),
+					resource.TestCheckNoResourceAttr("plural_cluster.test", "node_pools.general.labels.%"),
+					resource.TestCheckResourceAttr("plural_cluster.test", "node_pools.gpu.instance_type", "g5.xlarge"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccClusterResourceUpgrade(t *testing.T) {
+	console := testAccConsole(t)
+	setCluster := func(fields fakeconsole.Object) func() {
+		return func() {
+			for _, cluster := range console.List(fakeconsole.KindCluster) {
+				if err := console.Set(fakeconsole.KindCluster, cluster["id"].(string), fields); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(console, fakeconsole.KindCluster),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "plural_cluster" "test" {
+  name    = "test"
+  handle  = "test"
+  version = "1.29"
+}
+`,
+				Check: resource.TestCheckResourceAttr("plural_cluster.test", "version", "1.29"),
+			},
+			{
+				PreConfig: setCluster(fakeconsole.Object{"currentVersion": "1.30.2"}),
+				Config: `
+resource "plural_cluster" "test" {
+  name             = "test"
+  handle           = "test"
+  version          = "1.30"
+  wait_for_upgrade = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("plural_cluster.test", "version", "1.30"),
+					resource.TestCheckResourceAttr("plural_cluster.test", "current_version", "1.30.2"),
+				),
+			},
+			{
+				PreConfig: setCluster(fakeconsole.Object{"upgradePlan": map[string]any{"compatibilities": true, "deprecations": false}}),
+				Config: `
+resource "plural_cluster" "test" {
+  name             = "test"
+  handle           = "test"
+  version          = "1.31"
+  wait_for_upgrade = true
+}
+`,
+				ExpectError: regexp.MustCompile(`deprecated APIs are still in use`),
+			},
+		},
+	})
+}
+
+func TestAccClusterResourceUninstallAgentOnDestroy(t *testing.T) {
+	console := testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(console, fakeconsole.KindCluster),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "plural_cluster" "test" {
+  name                   = "test"
+  handle                 = "test"
+  remove_agent_namespace = true
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				// Without kubeconfig agent uninstallation is skipped with a warning and the cluster is still deleted.
+				Config: `
+resource "plural_cluster" "test" {
+  name                       = "test"
+  handle                     = "test"
+  uninstall_agent_on_destroy = true
+  remove_agent_namespace     = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExists(console, fakeconsole.KindCluster, "plural_cluster.test"),
+					resource.TestCheckResourceAttr("plural_cluster.test", "uninstall_agent_on_destroy", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccClusterResourceHelmRepoPassword(t *testing.T) {
+	console := testAccConsole(t)
+
+	config := func(password string) string {
+		return `
+resource "plural_cluster" "test" {
+  name               = "test"
+  handle             = "test"
+  helm_repo_url      = "oci://registry.example.com/charts"
+  helm_repo_username = "user"
+  helm_repo_password = "` + password + `"
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(console, fakeconsole.KindCluster),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("plural_cluster.test", "helm_repo_password"),
+					resource.TestCheckResourceAttr("plural_cluster.test", "helm_repo_password_hash", testAccSHA256("first")),
+				),
+			},
+			{
+				Config: config("second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("plural_cluster.test", "helm_repo_password"),
+					resource.TestCheckResourceAttr("plural_cluster.test", "helm_repo_password_hash", testAccSHA256("second")),
+				),
+			},
+		},
+	})
+}
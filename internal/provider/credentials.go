@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// tokenExpiryDelta is subtracted from token expiration time to refresh it before it actually expires.
+const tokenExpiryDelta = time.Minute
+
+// credentialsExec describes a command that provides Console access token.
+type credentialsExec struct {
+	Command types.String `tfsdk:"command"`
+	Args    types.List   `tfsdk:"args"`
+	Env     types.Map    `tfsdk:"env"`
+}
+
+// tokenSource provides Console access token.
+type tokenSource interface {
+	// Token returns a valid access token, refreshing it if needed.
+	Token(ctx context.Context) (string, error)
+
+	// Invalidate forces token refresh on the next call to Token. It returns false if token cannot be refreshed.
+	Invalidate() bool
+}
+
+type staticTokenSource string
+
+func (s staticTokenSource) Token(_ context.Context) (string, error) {
+	return string(s), nil
+}
+
+func (s staticTokenSource) Invalidate() bool {
+	return false
+}
+
+// fileTokenSource reads access token from a file. File is read again whenever it changes,
+// so it can be rotated by an external process during long applies.
+type fileTokenSource struct {
+	path string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+}
+
+func (s *fileTokenSource) Token(_ context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return "", fmt.Errorf("cannot read access token file: %w", err)
+	}
+
+	if s.token != "" && info.ModTime().Equal(s.modTime) {
+		return s.token, nil
+	}
+
+	content, err := os.ReadFile(s.path)
+	if err != nil {
+		return "", fmt.Errorf("cannot read access token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("access token file %s is empty", s.path)
+	}
+
+	s.token = token
+	s.modTime = info.ModTime()
+	return s.token, nil
+}
+
+func (s *fileTokenSource) Invalidate() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = ""
+	return true
+}
+
+// execCredential is the output of the credentials command. Command can also print just the token.
+type execCredential struct {
+	Token     string     `json:"token"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// execTokenSource runs a command to get access token. Token is cached until it expires.
+type execTokenSource struct {
+	command string
+	args    []string
+	env     map[string]string
+
+	mu        sync.Mutex
+	token     string
+	expiresAt *time.Time
+}
+
+func (s *execTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && (s.expiresAt == nil || time.Now().Add(tokenExpiryDelta).Before(*s.expiresAt)) {
+		return s.token, nil
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.command, s.args...)
+	cmd.Env = os.Environ()
+	for name, value := range s.env {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", name, value))
+	}
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("credentials command %s failed: %w: %s", s.command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	credential, err := parseExecCredential(stdout.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("credentials command %s returned invalid output: %w", s.command, err)
+	}
+
+	s.token = credential.Token
+	s.expiresAt = credential.ExpiresAt
+	return s.token, nil
+}
+
+func (s *execTokenSource) Invalidate() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = ""
+	return true
+}
+
+func parseExecCredential(output []byte) (*execCredential, error) {
+	output = bytes.TrimSpace(output)
+	if len(output) == 0 {
+		return nil, fmt.Errorf("output is empty")
+	}
+
+	if output[0] != '{' {
+		return &execCredential{Token: string(output)}, nil
+	}
+
+	credential := &execCredential{}
+	if err := json.Unmarshal(output, credential); err != nil {
+		return nil, err
+	}
+
+	if credential.Token == "" {
+		return nil, fmt.Errorf("token is empty")
+	}
+
+	return credential, nil
+}
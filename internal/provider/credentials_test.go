@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFileTokenSourceReadsRotatedToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("first\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tokens := &fileTokenSource{path: path}
+	if token, err := tokens.Token(context.Background()); err != nil || token != "first" {
+		t.Fatalf("expected token first, got %q (error: %v)", token, err)
+	}
+
+	if err := os.WriteFile(path, []byte("second"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+
+	if token, err := tokens.Token(context.Background()); err != nil || token != "second" {
+		t.Fatalf("expected token second, got %q (error: %v)", token, err)
+	}
+}
+
+func TestExecTokenSource(t *testing.T) {
+	cases := []struct {
+		name   string
+		script string
+		token  string
+	}{
+		{name: "plain", script: `echo "$TOKEN"`, token: "plain-token"},
+		{name: "json", script: `echo "{\"token\": \"$TOKEN\", \"expires_at\": \"2100-01-01T00:00:00Z\"}"`, token: "json-token"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			tokens := &execTokenSource{command: "sh", args: []string{"-c", c.script}, env: map[string]string{"TOKEN": c.token}}
+			token, err := tokens.Token(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if token != c.token {
+				t.Errorf("expected token %q, got %q", c.token, token)
+			}
+		})
+	}
+}
+
+func TestExecTokenSourceRefreshesExpiredToken(t *testing.T) {
+	counter := filepath.Join(t.TempDir(), "counter")
+	script := `echo x >> "$COUNTER"; echo "{\"token\": \"token-$(wc -l < "$COUNTER" | tr -d ' ')\", \"expires_at\": \"2000-01-01T00:00:00Z\"}"`
+	tokens := &execTokenSource{command: "sh", args: []string{"-c", script}, env: map[string]string{"COUNTER": counter}}
+
+	first, err := tokens.Token(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	second, err := tokens.Token(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if first != "token-1" || second != "token-2" {
+		t.Errorf("expected expired token to be refreshed, got %q and %q", first, second)
+	}
+}
+
+func TestExecTokenSourceFailure(t *testing.T) {
+	tokens := &execTokenSource{command: "sh", args: []string{"-c", "echo denied >&2; exit 1"}}
+	if _, err := tokens.Token(context.Background()); err == nil || !strings.Contains(err.Error(), "denied") {
+		t.Errorf("expected error with command output, got %v", err)
+	}
+}
+
+func TestAuthedTransportRefreshesRejectedToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("stale"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Token fresh" {
+			// Rotate the token, as an external helper would, and reject the stale one.
+			_ = os.WriteFile(path, []byte("fresh"), 0o600)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := http.Client{Transport: &authedTransport{tokens: &fileTokenSource{path: path}, wrapped: http.DefaultTransport}}
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader("query"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", resp.StatusCode)
+	}
+}
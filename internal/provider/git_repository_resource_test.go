@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-plural/internal/fakeconsole"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGitRepositoryResource(t *testing.T) {
+	console := testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(console, fakeconsole.KindGitRepository),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "plural_git_repository" "test" {
+  url = "https://github.com/pluralsh/console.git"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExists(console, fakeconsole.KindGitRepository, "plural_git_repository.test"),
+					resource.TestCheckResourceAttr("plural_git_repository.test", "url", "https://github.com/pluralsh/console.git"),
+				),
+			},
+			{
+				ResourceName:      "plural_git_repository.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
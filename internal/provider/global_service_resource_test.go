@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-plural/internal/fakeconsole"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGlobalServiceResource(t *testing.T) {
+	console := testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(console, fakeconsole.KindGlobalService),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceDeploymentDependencies + `
+resource "plural_service_deployment" "test" {
+  name      = "test"
+  namespace = "test"
+  cluster   = { id = plural_cluster.test.id }
+  repository = {
+    id     = plural_git_repository.test.id
+    ref    = "main"
+    folder = "charts/console"
+  }
+}
+
+resource "plural_global_service" "test" {
+  name       = "test"
+  service_id = plural_service_deployment.test.id
+  tags       = { env = "dev" }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExists(console, fakeconsole.KindGlobalService, "plural_global_service.test"),
+					resource.TestCheckResourceAttrPair("plural_global_service.test", "service_id", "plural_service_deployment.test", "id"),
+					resource.TestCheckResourceAttr("plural_global_service.test", "tags.env", "dev"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-plural/internal/fakeconsole"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccGroupMemberResource(t *testing.T) {
+	console := testAccConsole(t)
+	userID := console.Seed(fakeconsole.KindUser, fakeconsole.Object{"name": "member", "email": "member@example.com"})
+
+	config := `
+resource "plural_group" "test" {
+  name = "members"
+}
+
+resource "plural_group_member" "test" {
+  group_id = plural_group.test.id
+  user_id  = "` + userID + `"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(console, fakeconsole.KindGroupMember),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExists(console, fakeconsole.KindGroupMember, "plural_group_member.test"),
+					resource.TestCheckResourceAttrPair("plural_group_member.test", "group_id", "plural_group.test", "id"),
+					resource.TestCheckResourceAttr("plural_group_member.test", "user_id", userID),
+				),
+			},
+			{
+				ResourceName:      "plural_group_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccGroupMemberImportID("plural_group_member.test"),
+			},
+			{
+				PreConfig: func() {
+					for _, member := range console.List(fakeconsole.KindGroupMember) {
+						console.Delete(fakeconsole.KindGroupMember, member["id"].(string))
+					}
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExists(console, fakeconsole.KindGroupMember, "plural_group_member.test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGroupMemberImportID(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", name)
+		}
+
+		return fmt.Sprintf("%s,%s", rs.Primary.Attributes["group_id"], rs.Primary.Attributes["user_id"]), nil
+	}
+}
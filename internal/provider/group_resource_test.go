@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-plural/internal/fakeconsole"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGroupResource(t *testing.T) {
+	console := testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(console, fakeconsole.KindGroup),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "plural_group" "test" {
+  name        = "test"
+  description = "test group"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExists(console, fakeconsole.KindGroup, "plural_group.test"),
+					resource.TestCheckResourceAttr("plural_group.test", "name", "test"),
+					resource.TestCheckResourceAttr("plural_group.test", "description", "test group"),
+				),
+			},
+			{
+				Config: `
+resource "plural_group" "test" {
+  name        = "test"
+  description = "updated group"
+  global      = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("plural_group.test", "description", "updated group"),
+					resource.TestCheckResourceAttr("plural_group.test", "global", "true"),
+				),
+			},
+		},
+	})
+}
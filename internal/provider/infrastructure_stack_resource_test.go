@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-plural/internal/fakeconsole"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testAccInfrastructureStackConfig = testAccServiceDeploymentDependencies + `
+resource "plural_infrastructure_stack" "test" {
+  name       = "test"
+  type       = "TERRAFORM"
+  cluster_id = plural_cluster.test.id
+  repository = {
+    id     = plural_git_repository.test.id
+    ref    = "main"
+    folder = "terraform"
+  }
+  configuration = {
+    version = "1.8.0"
+  }
+  files = { "terraform.tfvars" = "region = \"us-east-1\"" }
+}
+`
+
+func TestAccInfrastructureStackResource(t *testing.T) {
+	console := testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(console, fakeconsole.KindInfrastructureStack),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInfrastructureStackConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExists(console, fakeconsole.KindInfrastructureStack, "plural_infrastructure_stack.test"),
+					resource.TestCheckResourceAttrPair("plural_infrastructure_stack.test", "cluster_id", "plural_cluster.test", "id"),
+					resource.TestCheckResourceAttr("plural_infrastructure_stack.test", "configuration.version", "1.8.0"),
+					resource.TestCheckResourceAttr("plural_infrastructure_stack.test", "repository.folder", "terraform"),
+				),
+			},
+			{
+				ResourceName:            "plural_infrastructure_stack.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"detach", "actor"},
+			},
+		},
+	})
+}
+
+func TestAccCustomStackRunResource(t *testing.T) {
+	console := testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(console, fakeconsole.KindCustomStackRun),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInfrastructureStackConfig + `
+resource "plural_custom_stack_run" "test" {
+  name     = "plan"
+  stack_id = plural_infrastructure_stack.test.id
+  commands = [{ cmd = "terraform", args = ["plan"] }]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExists(console, fakeconsole.KindCustomStackRun, "plural_custom_stack_run.test"),
+					resource.TestCheckResourceAttrPair("plural_custom_stack_run.test", "stack_id", "plural_infrastructure_stack.test", "id"),
+					resource.TestCheckResourceAttr("plural_custom_stack_run.test", "commands.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "plural_custom_stack_run.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccStackRunTriggerResource(t *testing.T) {
+	console := testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInfrastructureStackConfig + `
+resource "plural_stack_run_trigger" "test" {
+  id            = plural_infrastructure_stack.test.id
+  retrigger_key = "1"
+}
+`,
+				Check: func(_ *terraform.State) error {
+					if runs := console.List(fakeconsole.KindStackRun); len(runs) != 1 {
+						return fmt.Errorf("expected a single stack run, got %d", len(runs))
+					}
+					return nil
+				},
+			},
+			{
+				Config: testAccInfrastructureStackConfig + `
+resource "plural_stack_run_trigger" "test" {
+  id            = plural_infrastructure_stack.test.id
+  retrigger_key = "2"
+}
+`,
+				Check: func(_ *terraform.State) error {
+					if runs := console.List(fakeconsole.KindStackRun); len(runs) != 2 {
+						return fmt.Errorf("expected stack to be retriggered, got %d runs", len(runs))
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// maskedValue replaces sensitive values in logs.
+const maskedValue = "***"
+
+// sensitiveKeys are lowercase names of GraphQL variables and input fields whose values are masked in logs.
+// Names are matched after removing underscores, so both deployToken and deploy_token match.
+var sensitiveKeys = []string{
+	"token", "accesstoken", "deploytoken", "jwt", "password", "secret", "secrets", "clientsecret",
+	"privatekey", "sshkey", "passphrase", "kubeconfig", "configuration", "helmvalues",
+}
+
+// sensitiveKeySuffixes mask any key ending with one of them, i.e. apiToken or webhookSecret.
+var sensitiveKeySuffixes = []string{"token", "secret", "password", "privatekey"}
+
+// loggingTransport logs Console GraphQL requests and responses with tflog at the debug level.
+// It logs the operation name, variables with sensitive values masked, latency and returned errors.
+// Requests are passed through untouched unless debug logging is enabled, so response bodies are not buffered.
+type loggingTransport struct {
+	wrapped http.RoundTripper
+}
+
+type graphQLRequest struct {
+	OperationName string         `json:"operationName"`
+	Query         string         `json:"query"`
+	Variables     map[string]any `json:"variables"`
+}
+
+type graphQLResponse struct {
+	Errors []struct {
+		Message string `json:"message"`
+		Path    []any  `json:"path,omitempty"`
+	} `json:"errors"`
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !debugLogging() {
+		return t.wrapped.RoundTrip(req)
+	}
+
+	ctx := req.Context()
+	operation := graphQLRequest{}
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			_ = json.NewDecoder(body).Decode(&operation)
+			_ = body.Close()
+		}
+	}
+
+	fields := map[string]any{
+		"operation": operation.OperationName,
+		"variables": mask(operation.Variables),
+	}
+	tflog.Debug(ctx, "sending Console request", fields)
+
+	start := time.Now()
+	resp, err := t.wrapped.RoundTrip(req)
+	fields["latency"] = time.Since(start).String()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Console request failed", fields)
+		return resp, err
+	}
+
+	fields["status"] = resp.StatusCode
+	body, readErr := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if readErr != nil {
+		fields["error"] = readErr.Error()
+		tflog.Debug(ctx, "Console request failed", fields)
+		return nil, readErr
+	}
+
+	response := graphQLResponse{}
+	if json.Unmarshal(body, &response) == nil && len(response.Errors) > 0 {
+		errs := make([]string, 0, len(response.Errors))
+		for _, e := range response.Errors {
+			errs = append(errs, e.Message)
+		}
+		fields["errors"] = errs
+	}
+
+	tflog.Debug(ctx, "received Console response", fields)
+	return resp, nil
+}
+
+// debugLogging checks if Terraform logs provider messages at the debug level or below, as set with
+// TF_LOG_PROVIDER or TF_LOG.
+func debugLogging() bool {
+	level := os.Getenv("TF_LOG_PROVIDER")
+	if level == "" {
+		level = os.Getenv("TF_LOG")
+	}
+
+	switch strings.ToUpper(level) {
+	case "TRACE", "DEBUG", "JSON":
+		return true
+	default:
+		return false
+	}
+}
+
+// mask returns a copy of the value with all sensitive values replaced.
+func mask(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, val := range v {
+			if isSensitive(key) && val != nil {
+				result[key] = maskedValue
+				continue
+			}
+
+			result[key] = mask(val)
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, val := range v {
+			result[i] = mask(val)
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+func isSensitive(key string) bool {
+	key = strings.ToLower(strings.ReplaceAll(key, "_", ""))
+	for _, k := range sensitiveKeys {
+		if key == k {
+			return true
+		}
+	}
+
+	for _, suffix := range sensitiveKeySuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
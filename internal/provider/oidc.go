@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// oidcCredentials describe how to get an OIDC token that is exchanged for Console access token.
+type oidcCredentials struct {
+	Email     types.String `tfsdk:"email"`
+	Token     types.String `tfsdk:"token"`
+	TokenFile types.String `tfsdk:"token_file"`
+	Audience  types.String `tfsdk:"audience"`
+}
+
+// githubActionsClient requests OIDC tokens from GitHub Actions. It is separate from the Console client
+// as the Console TLS settings, proxy and custom headers must not be applied to GitHub requests.
+var githubActionsClient = &http.Client{Timeout: 30 * time.Second}
+
+// tokenExchange exchanges an OIDC token for Console access token of the user with given email.
+type tokenExchange func(ctx context.Context, token, email string) (string, error)
+
+// oidcTokenSource gets an OIDC token and exchanges it with the Console for a short-lived access token.
+// Access token is cached until it expires, then the whole exchange is repeated.
+type oidcTokenSource struct {
+	email    string
+	idToken  func(ctx context.Context) (string, error)
+	exchange tokenExchange
+
+	mu        sync.Mutex
+	token     string
+	expiresAt *time.Time
+}
+
+func (s *oidcTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && (s.expiresAt == nil || time.Now().Add(tokenExpiryDelta).Before(*s.expiresAt)) {
+		return s.token, nil
+	}
+
+	idToken, err := s.idToken(ctx)
+	if err != nil {
+		return "", fmt.Errorf("cannot get OIDC token: %w", err)
+	}
+
+	token, err := s.exchange(ctx, idToken, s.email)
+	if err != nil {
+		return "", fmt.Errorf("cannot exchange OIDC token: %w", err)
+	}
+
+	s.token = token
+	s.expiresAt = jwtExpiry(token)
+	return s.token, nil
+}
+
+func (s *oidcTokenSource) Invalidate() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = ""
+	return true
+}
+
+// newOIDCTokenSource creates a token source based on the provider configuration with fallback to environment variables.
+// OIDC token is read from the configuration, PLURAL_OIDC_TOKEN or PLURAL_OIDC_TOKEN_FILE, in that order.
+// If none of them is set and the provider runs in GitHub Actions, token is requested from the GitHub OIDC provider.
+func newOIDCTokenSource(credentials *oidcCredentials, exchange tokenExchange) (*oidcTokenSource, error) {
+	email := stringSetting(credentials.Email, "PLURAL_OIDC_EMAIL")
+	if email == "" {
+		return nil, fmt.Errorf("email is required, set it in the configuration or use the PLURAL_OIDC_EMAIL environment variable")
+	}
+
+	source := &oidcTokenSource{email: email, exchange: exchange}
+	tokenFile := stringSetting(credentials.TokenFile, "PLURAL_OIDC_TOKEN_FILE")
+	switch {
+	case !credentials.Token.IsNull():
+		source.idToken = staticTokenSource(credentials.Token.ValueString()).Token
+	case !credentials.TokenFile.IsNull():
+		source.idToken = (&fileTokenSource{path: tokenFile}).Token
+	case os.Getenv("PLURAL_OIDC_TOKEN") != "":
+		source.idToken = staticTokenSource(os.Getenv("PLURAL_OIDC_TOKEN")).Token
+	case tokenFile != "":
+		source.idToken = (&fileTokenSource{path: tokenFile}).Token
+	case os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL") != "":
+		audience := stringSetting(credentials.Audience, "PLURAL_OIDC_AUDIENCE")
+		source.idToken = func(ctx context.Context) (string, error) {
+			return githubActionsIDToken(ctx, githubActionsClient, audience)
+		}
+	default:
+		return nil, fmt.Errorf("no OIDC token found, set token or token file in the configuration, " +
+			"use the PLURAL_OIDC_TOKEN or PLURAL_OIDC_TOKEN_FILE environment variables, " +
+			"or run in GitHub Actions with the id-token: write permission")
+	}
+
+	return source, nil
+}
+
+// githubActionsIDToken requests an OIDC token from the GitHub Actions token endpoint.
+// See https://docs.github.com/en/actions/security-for-github-actions/security-hardening-your-deployments/about-security-hardening-with-openid-connect.
+func githubActionsIDToken(ctx context.Context, httpClient *http.Client, audience string) (string, error) {
+	requestURL, err := url.Parse(os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL"))
+	if err != nil {
+		return "", fmt.Errorf("cannot parse ACTIONS_ID_TOKEN_REQUEST_URL: %w", err)
+	}
+
+	if audience != "" {
+		query := requestURL.Query()
+		query.Set("audience", audience)
+		requestURL.RawQuery = query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN"))
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("GitHub Actions token endpoint returned unexpected status: %s", resp.Status)
+	}
+
+	var result struct {
+		Value string `json:"value"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("cannot decode GitHub Actions token endpoint response: %w", err)
+	}
+
+	if result.Value == "" {
+		return "", fmt.Errorf("GitHub Actions token endpoint returned an empty token")
+	}
+
+	return result.Value, nil
+}
+
+// jwtExpiry reads the expiration time from the JWT claims without verifying the signature.
+// It returns nil if the token is not a JWT or does not expire.
+func jwtExpiry(token string) *time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil
+	}
+
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err = json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return nil
+	}
+
+	expiresAt := time.Unix(claims.Exp, 0)
+	return &expiresAt
+}
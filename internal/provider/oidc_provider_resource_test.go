@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-plural/internal/fakeconsole"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOIDCProviderResource(t *testing.T) {
+	console := testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(console, fakeconsole.KindOIDCProvider),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "plural_oidc_provider" "test" {
+  name          = "test"
+  type          = "PLURAL"
+  description   = "test provider"
+  redirect_uris = ["https://example.com/callback"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("plural_oidc_provider.test", "client_id"),
+					resource.TestCheckResourceAttrSet("plural_oidc_provider.test", "client_secret"),
+					resource.TestCheckResourceAttr("plural_oidc_provider.test", "redirect_uris.#", "1"),
+				),
+			},
+			{
+				Config: `
+resource "plural_oidc_provider" "test" {
+  name          = "test"
+  type          = "PLURAL"
+  redirect_uris = ["https://example.com/callback", "https://example.com/other"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("plural_oidc_provider.test", "description"),
+					resource.TestCheckResourceAttr("plural_oidc_provider.test", "redirect_uris.#", "2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func testJWT(expiresAt time.Time) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp": %d}`, expiresAt.Unix())))
+	return "eyJhbGciOiJub25lIn0." + payload + ".signature"
+}
+
+func TestJWTExpiry(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	if result := jwtExpiry(testJWT(expiresAt)); result == nil || !result.Equal(expiresAt) {
+		t.Errorf("expected expiry %s, got %v", expiresAt, result)
+	}
+
+	if result := jwtExpiry("opaque-token"); result != nil {
+		t.Errorf("expected no expiry for opaque token, got %s", result)
+	}
+}
+
+func TestOIDCTokenSourceExchangesExpiredToken(t *testing.T) {
+	exchanges := 0
+	tokens := &oidcTokenSource{
+		email:   "user@example.com",
+		idToken: staticTokenSource("oidc-token").Token,
+		exchange: func(_ context.Context, token, email string) (string, error) {
+			if token != "oidc-token" || email != "user@example.com" {
+				return "", fmt.Errorf("unexpected exchange of %s for %s", token, email)
+			}
+
+			exchanges++
+			return testJWT(time.Now().Add(30 * time.Second)), nil
+		},
+	}
+
+	for range 2 {
+		if _, err := tokens.Token(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if exchanges != 2 {
+		t.Errorf("expected token expiring soon to be exchanged again, got %d exchanges", exchanges)
+	}
+}
+
+func TestGitHubActionsIDToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer request-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if r.URL.Query().Get("audience") != "plural" {
+			t.Errorf("expected audience plural, got %q", r.URL.Query().Get("audience"))
+		}
+
+		_, _ = w.Write([]byte(`{"value": "github-token"}`))
+	}))
+	defer server.Close()
+
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", server.URL+"/token?api-version=2.0")
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", "request-token")
+
+	token, err := githubActionsIDToken(context.Background(), http.DefaultClient, "plural")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if token != "github-token" {
+		t.Errorf("expected token github-token, got %q", token)
+	}
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-plural/internal/fakeconsole"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectResource(t *testing.T) {
+	console := testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "plural_project" "test" {
+  name        = "test"
+  description = "test project"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExists(console, fakeconsole.KindProject, "plural_project.test"),
+					resource.TestCheckResourceAttrSet("plural_project.test", "id"),
+					resource.TestCheckResourceAttr("plural_project.test", "name", "test"),
+					resource.TestCheckResourceAttr("plural_project.test", "description", "test project"),
+					resource.TestCheckResourceAttr("plural_project.test", "default", "false"),
+				),
+			},
+			{
+				ResourceName:      "plural_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: `
+resource "plural_project" "test" {
+  name        = "test"
+  description = "updated project"
+}
+`,
+				Check: resource.TestCheckResourceAttr("plural_project.test", "description", "updated project"),
+			},
+		},
+	})
+}
+
+func TestAccProjectResourceValidationError(t *testing.T) {
+	console := testAccConsole(t)
+	console.Handle("createProject", func(_ *fakeconsole.Server, _ map[string]any) (any, error) {
+		return nil, fmt.Errorf("name has already been taken")
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "plural_project" "test" {
+  name = "taken"
+}
+`,
+				ExpectError: regexp.MustCompile(`Unable to create project, got error: name has already been taken`),
+			},
+		},
+	})
+}
+
+func TestAccProjectResourceReadOnly(t *testing.T) {
+	console := testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(console, fakeconsole.KindProject),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "plural" {
+  read_only = true
+}
+
+resource "plural_project" "test" {
+  name = "test"
+}
+`,
+				ExpectError: regexp.MustCompile(`Cannot create resource, provider is configured in read-only mode`),
+			},
+		},
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"os"
 	"strconv"
+	"time"
 
 	internalclient "terraform-provider-plural/internal/client"
 	"terraform-provider-plural/internal/common"
@@ -13,8 +14,11 @@ import (
 	r "terraform-provider-plural/internal/resource"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -35,20 +39,25 @@ type PluralProvider struct {
 
 // pluralProviderModel describes the Plural provider data model.
 type pluralProviderModel struct {
-	ConsoleUrl  types.String       `tfsdk:"console_url"`
-	AccessToken types.String       `tfsdk:"access_token"`
-	UseCli      types.Bool         `tfsdk:"use_cli"`
-	Kubeconfig  *common.Kubeconfig `tfsdk:"kubeconfig"`
-}
-
-type authedTransport struct {
-	token   string
-	wrapped http.RoundTripper
-}
-
-func (t *authedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	req.Header.Set("Authorization", "Token "+t.token)
-	return t.wrapped.RoundTrip(req)
+	ConsoleUrl         types.String       `tfsdk:"console_url"`
+	AccessToken        types.String       `tfsdk:"access_token"`
+	UseCli             types.Bool         `tfsdk:"use_cli"`
+	AccessTokenFile    types.String       `tfsdk:"access_token_file"`
+	Exec               *credentialsExec   `tfsdk:"exec"`
+	Oidc               *oidcCredentials   `tfsdk:"oidc"`
+	Kubeconfig         *common.Kubeconfig `tfsdk:"kubeconfig"`
+	MaxRetries         types.Int64        `tfsdk:"max_retries"`
+	RetryWaitMin       types.String       `tfsdk:"retry_wait_min"`
+	RetryWaitMax       types.String       `tfsdk:"retry_wait_max"`
+	MaxInFlight        types.Int64        `tfsdk:"max_in_flight"`
+	CaCertificate      types.String       `tfsdk:"ca_certificate"`
+	InsecureSkipVerify types.Bool         `tfsdk:"insecure_skip_verify"`
+	ProxyUrl           types.String       `tfsdk:"proxy_url"`
+	TlsServerName      types.String       `tfsdk:"tls_server_name"`
+	Headers            types.Map          `tfsdk:"headers"`
+	DefaultProjectId   types.String       `tfsdk:"default_project_id"`
+	DefaultTags        types.Map          `tfsdk:"default_tags"`
+	ReadOnly           types.Bool         `tfsdk:"read_only"`
 }
 
 func (p *PluralProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -63,7 +72,12 @@ func (p *PluralProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				MarkdownDescription: "Plural Console URL, i.e. `https://console.demo.onplural.sh`. Can be sourced from `PLURAL_CONSOLE_URL`.",
 				Optional:            true,
 				Validators: []validator.String{
-					stringvalidator.AlsoRequires(path.MatchRoot("access_token")),
+					stringvalidator.AtLeastOneOf(
+						path.MatchRoot("access_token"),
+						path.MatchRoot("access_token_file"),
+						path.MatchRoot("exec"),
+						path.MatchRoot("oidc"),
+					),
 					stringvalidator.ConflictsWith(path.MatchRoot("use_cli")),
 				},
 			},
@@ -73,7 +87,87 @@ func (p *PluralProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				Sensitive:           true,
 				Validators: []validator.String{
 					stringvalidator.AlsoRequires(path.MatchRoot("console_url")),
-					stringvalidator.ConflictsWith(path.MatchRoot("use_cli")),
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("use_cli"),
+						path.MatchRoot("access_token_file"),
+						path.MatchRoot("exec"),
+						path.MatchRoot("oidc"),
+					),
+				},
+			},
+			"access_token_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing Plural Console access token. " +
+					"The file is read again whenever it changes, so the token can be rotated during long applies. " +
+					"Can be sourced from `PLURAL_ACCESS_TOKEN_FILE`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("console_url")),
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("use_cli"),
+						path.MatchRoot("exec"),
+						path.MatchRoot("oidc"),
+					),
+				},
+			},
+			"exec": schema.SingleNestedAttribute{
+				MarkdownDescription: "Specifies a command to provide Plural Console access token. " +
+					"The command should print either the token or a JSON object with `token` and optional `expires_at` (RFC 3339) fields to its standard output. " +
+					"The command is run again when the token expires or when the Console rejects it.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"command": schema.StringAttribute{
+						MarkdownDescription: "Command to execute.",
+						Required:            true,
+					},
+					"args": schema.ListAttribute{
+						MarkdownDescription: "Arguments to pass to the command when executing it.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"env": schema.MapAttribute{
+						MarkdownDescription: "Defines environment variables to expose to the process.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(path.MatchRoot("console_url")),
+					objectvalidator.ConflictsWith(
+						path.MatchRoot("use_cli"),
+						path.MatchRoot("oidc"),
+					),
+				},
+			},
+			"oidc": schema.SingleNestedAttribute{
+				MarkdownDescription: "Exchanges an OIDC token, i.e. one issued to a GitHub Actions or GitLab CI job, for a short-lived Plural Console access token. " +
+					"It requires a federated credential matching the token issuer and claims to be configured for the user in the Console. " +
+					"In order to source its fields from environment variables it has to be defined, at least as an empty object. " +
+					"OIDC token is read from `token`, `token_file`, `PLURAL_OIDC_TOKEN` or `PLURAL_OIDC_TOKEN_FILE`, in that order. " +
+					"If none of them is set, the token is requested from the GitHub Actions OIDC provider, which requires the `id-token: write` permission.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"email": schema.StringAttribute{
+						MarkdownDescription: "Email of the Console user to get access token for. Can be sourced from `PLURAL_OIDC_EMAIL`.",
+						Optional:            true,
+					},
+					"token": schema.StringAttribute{
+						MarkdownDescription: "OIDC token to exchange. Can be sourced from `PLURAL_OIDC_TOKEN`, i.e. when using GitLab CI `id_tokens`.",
+						Optional:            true,
+						Sensitive:           true,
+						Validators:          []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("oidc").AtName("token_file"))},
+					},
+					"token_file": schema.StringAttribute{
+						MarkdownDescription: "Path to a file containing OIDC token to exchange. Can be sourced from `PLURAL_OIDC_TOKEN_FILE`.",
+						Optional:            true,
+					},
+					"audience": schema.StringAttribute{
+						MarkdownDescription: "Audience of the OIDC token requested from GitHub Actions. Can be sourced from `PLURAL_OIDC_AUDIENCE`.",
+						Optional:            true,
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(path.MatchRoot("console_url")),
+					objectvalidator.ConflictsWith(path.MatchRoot("use_cli")),
 				},
 			},
 			"use_cli": schema.BoolAttribute{
@@ -83,10 +177,65 @@ func (p *PluralProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 					boolvalidator.ConflictsWith(
 						path.MatchRoot("console_url"),
 						path.MatchRoot("access_token"),
+						path.MatchRoot("access_token_file"),
+						path.MatchRoot("exec"),
+						path.MatchRoot("oidc"),
 					),
 				},
 			},
 			"kubeconfig": common.KubeconfigProviderSchema(),
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries of Console API requests that failed with a transient error, i.e. 429, 502, 503 or 504. Defaults to `4`. Set to `0` to disable retries. Can be sourced from `PLURAL_MAX_RETRIES`.",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"retry_wait_min": schema.StringAttribute{
+				MarkdownDescription: "Minimum time to wait between retries, i.e. `1s`. Backoff grows exponentially with jitter from this value. Defaults to `1s`. Can be sourced from `PLURAL_RETRY_WAIT_MIN`.",
+				Optional:            true,
+			},
+			"retry_wait_max": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait between retries, i.e. `30s`. `Retry-After` header sent by the Console takes precedence if it is longer. Defaults to `30s`. Can be sourced from `PLURAL_RETRY_WAIT_MAX`.",
+				Optional:            true,
+			},
+			"max_in_flight": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of concurrent Console API requests. Defaults to `0`, which means no limit. Can be sourced from `PLURAL_MAX_IN_FLIGHT`.",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded CA certificate bundle used to verify the Console certificate in addition to the system trust store. Also used when downloading the agent chart. Can be sourced from `PLURAL_CA_CERTIFICATE`.",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skips the validity check for the Console certificate. This will make your HTTPS connections insecure. Can be sourced from `PLURAL_INSECURE_SKIP_VERIFY`.",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "The URL to the proxy to be used for all requests made to the Console, i.e. `http://proxy.example.com:3128`. If not set, proxy is read from `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY`. Can be sourced from `PLURAL_PROXY_URL`.",
+				Optional:            true,
+			},
+			"tls_server_name": schema.StringAttribute{
+				MarkdownDescription: "Server name used to verify the Console certificate. If it is empty, the hostname from Console URL is used. Can be sourced from `PLURAL_TLS_SERVER_NAME`.",
+				Optional:            true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Additional headers to send with every request made to the Console, i.e. ones required by a proxy or gateway in front of it.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Makes the provider fail every create, update and delete, including agent installation and triggers, before any request is sent. Plans and data sources work as usual, so it can be used with a token that only has read access. Can be sourced from `PLURAL_READ_ONLY`.",
+				Optional:            true,
+			},
+			"default_project_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the project used by clusters, stacks, service contexts, workbenches and workbench tools that do not set `project_id`. Can be sourced from `PLURAL_DEFAULT_PROJECT_ID`.",
+				Optional:            true,
+			},
+			"default_tags": schema.MapAttribute{
+				MarkdownDescription: "Tags added to all clusters. Tags set on a cluster take precedence. Merged tags are exposed in the cluster `tags_all` attribute.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
 		},
 	}
 }
@@ -114,10 +263,39 @@ func (p *PluralProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		useCli = data.UseCli.ValueBool()
 	}
 
+	readOnly, _ := strconv.ParseBool(os.Getenv("PLURAL_READ_ONLY"))
+	if !data.ReadOnly.IsNull() {
+		readOnly = data.ReadOnly.ValueBool()
+	}
+
+	maxRetries, retryWaitMin, retryWaitMax, maxInFlight := retrySettings(data, &resp.Diagnostics)
+	transport, err := newBaseTransport(connectionSettings(data))
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Connection Settings", fmt.Sprintf("Unable to configure Console connection, got error: %s", err))
+	}
+
+	headers := make(map[string]string, len(data.Headers.Elements()))
+	resp.Diagnostics.Append(data.Headers.ElementsAs(ctx, &headers, false)...)
+
+	defaults := &common.Defaults{
+		ProjectId: stringSetting(data.DefaultProjectId, "PLURAL_DEFAULT_PROJECT_ID"),
+		Tags:      make(map[string]string, len(data.DefaultTags.Elements())),
+	}
+	resp.Diagnostics.Append(data.DefaultTags.ElementsAs(ctx, &defaults.Tags, false)...)
+
+	baseClient := &http.Client{
+		Transport: &headersTransport{
+			headers: headers,
+			wrapped: newRetryTransport(transport, maxRetries, retryWaitMin, retryWaitMax, maxInFlight),
+		},
+	}
+
+	var tokens tokenSource
 	if useCli {
 		config := console.ReadConfig()
 		accessToken = config.Token
 		consoleUrl = config.Url
+		tokens = staticTokenSource(accessToken)
 
 		if consoleUrl == "" {
 			resp.Diagnostics.AddAttributeError(
@@ -150,12 +328,14 @@ func (p *PluralProvider) Configure(ctx context.Context, req provider.ConfigureRe
 			)
 		}
 
-		if accessToken == "" {
+		tokens = credentialsSource(ctx, data, accessToken, consoleUrl, baseClient, &resp.Diagnostics)
+		if tokens == nil && !resp.Diagnostics.HasError() {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("access_token"),
 				"Missing Plural Console Access Token",
 				"The provider cannot create the Plural Console client as there is a missing or empty value for the Plural Console access token. "+
-					"Set the URL value in the configuration or use the PLURAL_ACCESS_TOKEN environment variable. "+
+					"Set the access token, access token file, exec or OIDC credentials in the configuration "+
+					"or use the PLURAL_ACCESS_TOKEN or PLURAL_ACCESS_TOKEN_FILE environment variables. "+
 					"If either is already set, ensure the value is not empty. "+
 					"You can also use Plural CLI for authentication, see documentation for more information.",
 			)
@@ -164,7 +344,6 @@ func (p *PluralProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	data.Kubeconfig.FromEnvVars()
 	var kubeClient *common.KubeClient
-	var err error
 	if data.Kubeconfig != nil {
 		kubeClient, err = common.NewKubeClient(ctx, data.Kubeconfig, lo.ToPtr(console.OperatorNamespace))
 		if err != nil {
@@ -176,18 +355,159 @@ func (p *PluralProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	var consoleTransport http.RoundTripper = &authedTransport{
+		tokens:  tokens,
+		wrapped: baseClient.Transport,
+	}
+	if readOnly {
+		consoleTransport = &readOnlyTransport{wrapped: consoleTransport}
+	}
+
 	httpClient := http.Client{
-		Transport: &authedTransport{
-			token:   accessToken,
-			wrapped: http.DefaultTransport,
+		Transport: &loggingTransport{
+			wrapped: consoleTransport,
 		},
 	}
 
 	consoleClient := client.NewClient(&httpClient, fmt.Sprintf("%s/gql", consoleUrl), nil)
 	internalClient := internalclient.NewClient(consoleClient)
+	internalClient.SetReadOnly(readOnly)
+
+	resp.ResourceData = common.NewProviderData(internalClient, consoleUrl, kubeClient, baseClient, defaults)
+	resp.DataSourceData = common.NewProviderData(internalClient, consoleUrl, kubeClient, baseClient, defaults)
+}
+
+// credentialsSource returns the token source based on the provider configuration with fallback to environment variables.
+// OIDC credentials take precedence over exec credentials, then access token file and access token.
+// It returns nil if no credentials are configured.
+func credentialsSource(ctx context.Context, data pluralProviderModel, accessToken, consoleUrl string, httpClient *http.Client, diags *diag.Diagnostics) tokenSource {
+	if diags.HasError() {
+		return nil
+	}
+
+	var tokens tokenSource
+	var attribute string
+	switch {
+	case data.Oidc != nil:
+		// Token exchange is not authenticated, so it uses the base client.
+		exchangeHTTPClient := &http.Client{Transport: &loggingTransport{wrapped: httpClient.Transport}}
+		exchangeClient := internalclient.NewClient(client.NewClient(exchangeHTTPClient, fmt.Sprintf("%s/gql", consoleUrl), nil))
+		oidcTokens, err := newOIDCTokenSource(data.Oidc, exchangeClient.ExchangeToken)
+		if err != nil {
+			diags.AddAttributeError(path.Root("oidc"), "Invalid OIDC Credentials", err.Error())
+			return nil
+		}
+		tokens = oidcTokens
+		attribute = "oidc"
+	case data.Exec != nil:
+		args := make([]string, 0, len(data.Exec.Args.Elements()))
+		diags.Append(data.Exec.Args.ElementsAs(ctx, &args, false)...)
+		env := make(map[string]string, len(data.Exec.Env.Elements()))
+		diags.Append(data.Exec.Env.ElementsAs(ctx, &env, false)...)
+		tokens = &execTokenSource{command: data.Exec.Command.ValueString(), args: args, env: env}
+		attribute = "exec"
+	case !data.AccessTokenFile.IsNull():
+		tokens = &fileTokenSource{path: data.AccessTokenFile.ValueString()}
+		attribute = "access_token_file"
+	case accessToken != "":
+		return staticTokenSource(accessToken)
+	case os.Getenv("PLURAL_ACCESS_TOKEN_FILE") != "":
+		tokens = &fileTokenSource{path: os.Getenv("PLURAL_ACCESS_TOKEN_FILE")}
+		attribute = "access_token_file"
+	default:
+		return nil
+	}
+
+	if diags.HasError() {
+		return nil
+	}
+
+	if _, err := tokens.Token(ctx); err != nil {
+		diags.AddAttributeError(path.Root(attribute), "Cannot Read Plural Console Access Token", err.Error())
+		return nil
+	}
+
+	return tokens
+}
+
+// retrySettings reads the transport retry settings from the provider configuration with fallback
+// to environment variables and defaults.
+func retrySettings(data pluralProviderModel, diags *diag.Diagnostics) (maxRetries int, waitMin, waitMax time.Duration, maxInFlight int) {
+	maxRetries = intSetting(data.MaxRetries, "max_retries", "PLURAL_MAX_RETRIES", defaultMaxRetries, diags)
+	waitMin = durationSetting(data.RetryWaitMin, "retry_wait_min", "PLURAL_RETRY_WAIT_MIN", defaultRetryWaitMin, diags)
+	waitMax = durationSetting(data.RetryWaitMax, "retry_wait_max", "PLURAL_RETRY_WAIT_MAX", defaultRetryWaitMax, diags)
+	maxInFlight = intSetting(data.MaxInFlight, "max_in_flight", "PLURAL_MAX_IN_FLIGHT", 0, diags)
+
+	if waitMax < waitMin {
+		diags.AddAttributeError(
+			path.Root("retry_wait_max"),
+			"Invalid Retry Wait",
+			fmt.Sprintf("Maximum retry wait (%s) cannot be lower than minimum retry wait (%s).", waitMax, waitMin),
+		)
+	}
+
+	return
+}
+
+// connectionSettings reads the TLS and proxy settings from the provider configuration with fallback
+// to environment variables.
+func connectionSettings(data pluralProviderModel) tlsSettings {
+	insecure, _ := strconv.ParseBool(os.Getenv("PLURAL_INSECURE_SKIP_VERIFY"))
+	if !data.InsecureSkipVerify.IsNull() {
+		insecure = data.InsecureSkipVerify.ValueBool()
+	}
+
+	return tlsSettings{
+		caCertificate:      stringSetting(data.CaCertificate, "PLURAL_CA_CERTIFICATE"),
+		insecureSkipVerify: insecure,
+		tlsServerName:      stringSetting(data.TlsServerName, "PLURAL_TLS_SERVER_NAME"),
+		proxyURL:           stringSetting(data.ProxyUrl, "PLURAL_PROXY_URL"),
+	}
+}
+
+func stringSetting(value types.String, env string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+
+	return os.Getenv(env)
+}
+
+func intSetting(value types.Int64, attribute, env string, defaultValue int, diags *diag.Diagnostics) int {
+	if !value.IsNull() {
+		return int(value.ValueInt64())
+	}
+
+	if v := os.Getenv(env); v != "" {
+		result, err := strconv.Atoi(v)
+		if err != nil || result < 0 {
+			diags.AddAttributeError(path.Root(attribute), "Invalid Environment Variable",
+				fmt.Sprintf("Expected %s to be a non-negative integer, got: %q", env, v))
+		}
+
+		return result
+	}
+
+	return defaultValue
+}
+
+func durationSetting(value types.String, attribute, env string, defaultValue time.Duration, diags *diag.Diagnostics) time.Duration {
+	v := os.Getenv(env)
+	if !value.IsNull() {
+		v = value.ValueString()
+	}
+
+	if v == "" {
+		return defaultValue
+	}
+
+	result, err := time.ParseDuration(v)
+	if err != nil || result <= 0 {
+		diags.AddAttributeError(path.Root(attribute), "Invalid Duration",
+			fmt.Sprintf("Expected %s to be a positive duration, i.e. 1s or 1m, got: %q", attribute, v))
+	}
 
-	resp.ResourceData = common.NewProviderData(internalClient, consoleUrl, kubeClient)
-	resp.DataSourceData = common.NewProviderData(internalClient, consoleUrl, kubeClient)
+	return result
 }
 
 func (p *PluralProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -213,6 +533,7 @@ func (p *PluralProvider) Resources(_ context.Context) []func() resource.Resource
 		r.NewCloudConnectionResource,
 		r.NewServiceAccountResource,
 		r.NewServiceWaitResource,
+		r.NewServicesWaitResource,
 		r.NewWorkbenchResource,
 		r.NewWorkbenchToolResource,
 		r.NewWorkbenchCronResource,
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-plural/internal/fakeconsole"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccProtoV6ProviderFactories are used to instantiate the provider during acceptance testing.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"plural": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testAccConsole starts a fake Console API and points the provider at it.
+func testAccConsole(t *testing.T) *fakeconsole.Server {
+	t.Helper()
+
+	console := fakeconsole.New()
+	t.Cleanup(console.Close)
+
+	t.Setenv("PLURAL_CONSOLE_URL", console.URL)
+	t.Setenv("PLURAL_ACCESS_TOKEN", "test-token")
+	t.Setenv("PLURAL_USE_CLI", "false")
+
+	return console
+}
+
+// testAccCheckDestroyed verifies that no objects of the given kind are left in the fake Console.
+func testAccCheckDestroyed(console *fakeconsole.Server, kind string) func(*terraform.State) error {
+	return func(_ *terraform.State) error {
+		if objects := console.List(kind); len(objects) > 0 {
+			return fmt.Errorf("expected all %s objects to be destroyed, got %d", kind, len(objects))
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckExists verifies that the object referenced by the resource ID exists in the fake Console.
+func testAccCheckExists(console *fakeconsole.Server, kind, name string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", name)
+		}
+
+		if console.Get(kind, rs.Primary.ID) == nil {
+			return fmt.Errorf("%s %s does not exist in the fake Console", kind, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
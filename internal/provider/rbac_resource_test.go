@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-plural/internal/fakeconsole"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccRbacResource(t *testing.T) {
+	console := testAccConsole(t)
+	userID := console.Seed(fakeconsole.KindUser, fakeconsole.Object{"name": "reader", "email": "reader@example.com"})
+	clusterID := console.Seed(fakeconsole.KindCluster, fakeconsole.Object{"name": "test", "handle": "test"})
+
+	config := `
+resource "plural_rbac" "test" {
+  cluster_id = "` + clusterID + `"
+  bindings = {
+    read = [{ user_id = "` + userID + `" }]
+  }
+  baseline_bindings = {
+    write = [{ user_id = "` + userID + `" }]
+  }
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			cluster := console.Get(fakeconsole.KindCluster, clusterID)
+			if bindings, _ := cluster["readBindings"].([]any); len(bindings) != 0 {
+				return fmt.Errorf("expected read bindings to be removed, got %v", bindings)
+			}
+			if bindings, _ := cluster["writeBindings"].([]any); len(bindings) != 1 {
+				return fmt.Errorf("expected baseline write binding to be restored, got %v", bindings)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("plural_rbac.test", "bindings.read.#", "1"),
+					testAccCheckRbacBindings(console, clusterID, 1),
+				),
+			},
+			{
+				ResourceName:                         "plural_rbac.test",
+				ImportState:                          true,
+				ImportStateId:                        "cluster:" + clusterID,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "cluster_id",
+				ImportStateVerifyIgnore:              []string{"baseline_bindings"},
+			},
+			{
+				PreConfig: func() {
+					if err := console.Set(fakeconsole.KindCluster, clusterID, fakeconsole.Object{"readBindings": []any{}}); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config,
+				Check:  testAccCheckRbacBindings(console, clusterID, 1),
+			},
+		},
+	})
+}
+
+func TestAccRbacResourceImportInvalidIdentifier(t *testing.T) {
+	testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:        `resource "plural_rbac" "test" {}`,
+				ResourceName:  "plural_rbac.test",
+				ImportState:   true,
+				ImportStateId: "stack:123",
+				ExpectError:   regexp.MustCompile("Unexpected Import Identifier"),
+			},
+		},
+	})
+}
+
+func testAccCheckRbacBindings(console *fakeconsole.Server, clusterID string, expected int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		cluster := console.Get(fakeconsole.KindCluster, clusterID)
+		if bindings, _ := cluster["readBindings"].([]any); len(bindings) != expected {
+			return fmt.Errorf("expected cluster to have %d read bindings, got %v", expected, cluster["readBindings"])
+		}
+		return nil
+	}
+}
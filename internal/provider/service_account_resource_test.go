@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-plural/internal/fakeconsole"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServiceAccountResource(t *testing.T) {
+	console := testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "plural_service_account" "test" {
+  name  = "ci"
+  email = "ci@example.com"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExists(console, fakeconsole.KindUser, "plural_service_account.test"),
+					resource.TestCheckResourceAttr("plural_service_account.test", "name", "ci"),
+				),
+			},
+			{
+				ResourceName:                         "plural_service_account.test",
+				ImportState:                          true,
+				ImportStateId:                        "ci@example.com",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "email",
+			},
+		},
+	})
+}
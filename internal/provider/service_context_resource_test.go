@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-plural/internal/fakeconsole"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServiceContextResource(t *testing.T) {
+	console := testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(console, fakeconsole.KindServiceContext),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "plural_service_context" "test" {
+  name          = "test"
+  configuration = jsonencode({ region = "us-east-1" })
+  secrets       = { token = "secret" }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExists(console, fakeconsole.KindServiceContext, "plural_service_context.test"),
+					resource.TestCheckResourceAttr("plural_service_context.test", "configuration", `{"region":"us-east-1"}`),
+				),
+			},
+			{
+				Config: `
+resource "plural_service_context" "test" {
+  name          = "test"
+  configuration = jsonencode({ region = "eu-west-1" })
+  secrets       = { token = "secret" }
+}
+`,
+				Check: resource.TestCheckResourceAttr("plural_service_context.test", "configuration", `{"region":"eu-west-1"}`),
+			},
+		},
+	})
+}
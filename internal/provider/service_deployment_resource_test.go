@@ -0,0 +1,388 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-plural/internal/fakeconsole"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+const testAccServiceDeploymentDependencies = `
+resource "plural_cluster" "test" {
+  name   = "test"
+  handle = "test"
+}
+
+resource "plural_git_repository" "test" {
+  url = "https://github.com/pluralsh/console.git"
+}
+`
+
+// testAccServiceDeploymentImportIgnore lists attributes that are not read back from the API.
+// Imported configuration is stored as secret hashes, as it cannot be told apart from secrets.
+var testAccServiceDeploymentImportIgnore = []string{"version", "repository", "configuration", "secret_hashes"}
+
+func TestAccServiceDeploymentResource(t *testing.T) {
+	console := testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(console, fakeconsole.KindServiceDeployment),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceDeploymentDependencies + `
+resource "plural_service_deployment" "test" {
+  name      = "test"
+  namespace = "test"
+  cluster   = { id = plural_cluster.test.id }
+  repository = {
+    id     = plural_git_repository.test.id
+    ref    = "main"
+    folder = "charts/console"
+  }
+  configuration = { key = "value" }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExists(console, fakeconsole.KindServiceDeployment, "plural_service_deployment.test"),
+					resource.TestCheckResourceAttrPair("plural_service_deployment.test", "cluster.id", "plural_cluster.test", "id"),
+					resource.TestCheckResourceAttr("plural_service_deployment.test", "cluster.handle", "test"),
+					resource.TestCheckResourceAttr("plural_service_deployment.test", "repository.ref", "main"),
+					resource.TestCheckResourceAttr("plural_service_deployment.test", "repository.folder", "charts/console"),
+					resource.TestCheckResourceAttr("plural_service_deployment.test", "configuration.key", "value"),
+					resource.TestCheckResourceAttrSet("plural_service_deployment.test", "version"),
+				),
+			},
+			{
+				ResourceName:            "plural_service_deployment.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: testAccServiceDeploymentImportIgnore,
+			},
+			{
+				ResourceName:            "plural_service_deployment.test",
+				ImportState:             true,
+				ImportStateId:           "@test/test",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: testAccServiceDeploymentImportIgnore,
+			},
+			{
+				Config: testAccServiceDeploymentDependencies + `
+resource "plural_service_deployment" "test" {
+  name      = "test"
+  namespace = "test"
+  cluster   = { id = plural_cluster.test.id }
+  repository = {
+    id     = plural_git_repository.test.id
+    ref    = "release"
+    folder = "charts/console"
+  }
+  configuration = { key = "updated" }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("plural_service_deployment.test", "repository.ref", "release"),
+					resource.TestCheckResourceAttr("plural_service_deployment.test", "configuration.key", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceDeploymentResourceSecrets(t *testing.T) {
+	console := testAccConsole(t)
+
+	config := func(secret string) string {
+		return testAccServiceDeploymentDependencies + `
+resource "plural_service_deployment" "test" {
+  name          = "test"
+  namespace     = "test"
+  cluster       = { id = plural_cluster.test.id }
+  repository    = { id = plural_git_repository.test.id }
+  configuration = { key = "value" }
+  secrets       = { password = "` + secret + `" }
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("plural_service_deployment.test", "secrets.%"),
+					resource.TestCheckResourceAttr("plural_service_deployment.test", "configuration.%", "1"),
+					resource.TestCheckResourceAttr("plural_service_deployment.test", "secret_hashes.password", testAccSHA256("first")),
+					testAccCheckServiceConfiguration(console, "password", "first"),
+				),
+			},
+			{
+				Config: config("second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("plural_service_deployment.test", "secret_hashes.password", testAccSHA256("second")),
+					testAccCheckServiceConfiguration(console, "password", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceDeploymentResourceImportSecrets(t *testing.T) {
+	console := testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceDeploymentDependencies + `
+resource "plural_service_deployment" "test" {
+  name          = "test"
+  namespace     = "test"
+  cluster       = { id = plural_cluster.test.id }
+  repository    = { id = plural_git_repository.test.id }
+  configuration = { key = "value" }
+  secrets       = { password = "plaintext" }
+}
+`,
+				Check: testAccCheckServiceConfiguration(console, "password", "plaintext"),
+			},
+			{
+				ResourceName: "plural_service_deployment.test",
+				ImportState:  true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported state, got %d", len(states))
+					}
+
+					attributes := states[0].Attributes
+					for name, value := range attributes {
+						if value == "plaintext" {
+							return fmt.Errorf("secret value found in imported state attribute %s", name)
+						}
+					}
+
+					if _, ok := attributes["configuration.password"]; ok {
+						return fmt.Errorf("expected secret to be kept out of imported configuration")
+					}
+
+					if hash := attributes["secret_hashes.password"]; hash != testAccSHA256("plaintext") {
+						return fmt.Errorf("expected imported secret hash %s, got %s", testAccSHA256("plaintext"), hash)
+					}
+
+					if count := attributes["configuration.%"]; count != "" && count != "0" {
+						return fmt.Errorf("expected configuration to be kept out of imported state, got %s values", count)
+					}
+
+					if hash := attributes["secret_hashes.key"]; hash != testAccSHA256("value") {
+						return fmt.Errorf("expected imported configuration hash %s, got %s", testAccSHA256("value"), hash)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccSHA256(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+func testAccCheckServiceConfiguration(console *fakeconsole.Server, name, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		service := console.Get(fakeconsole.KindServiceDeployment, s.RootModule().Resources["plural_service_deployment.test"].Primary.ID)
+		configuration, _ := service["configuration"].([]any)
+		for _, c := range configuration {
+			if c := c.(map[string]any); c["name"] == name {
+				if c["value"] != value {
+					return fmt.Errorf("expected configuration %s to be %q, got %q", name, value, c["value"])
+				}
+				return nil
+			}
+		}
+
+		return fmt.Errorf("configuration %s not found in %v", name, configuration)
+	}
+}
+
+func TestAccServiceDeploymentResourceDependenciesAndContexts(t *testing.T) {
+	console := testAccConsole(t)
+	contextID := console.Seed(fakeconsole.KindServiceContext, fakeconsole.Object{"name": "shared"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceDeploymentDependencies + `
+resource "plural_service_deployment" "test" {
+  name         = "ingress"
+  namespace    = "ingress"
+  cluster      = { id = plural_cluster.test.id }
+  repository   = { id = plural_git_repository.test.id }
+  dependencies = ["cert-manager"]
+  contexts     = ["shared"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("plural_service_deployment.test", "dependencies.*", "cert-manager"),
+					resource.TestCheckTypeSetElemAttr("plural_service_deployment.test", "contexts.*", "shared"),
+					func(s *terraform.State) error {
+						service := console.Get(fakeconsole.KindServiceDeployment, s.RootModule().Resources["plural_service_deployment.test"].Primary.ID)
+						bindings, _ := service["contextBindings"].([]any)
+						if len(bindings) != 1 || bindings[0].(map[string]any)["contextId"] != contextID {
+							return fmt.Errorf("expected service to be bound to context %s, got %v", contextID, bindings)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccServiceDeploymentDependencies + `
+resource "plural_service_deployment" "test" {
+  name         = "ingress"
+  namespace    = "ingress"
+  cluster      = { id = plural_cluster.test.id }
+  repository   = { id = plural_git_repository.test.id }
+  dependencies = ["cert-manager", "external-dns"]
+  contexts     = ["` + contextID + `"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("plural_service_deployment.test", "dependencies.#", "2"),
+					resource.TestCheckTypeSetElemAttr("plural_service_deployment.test", "contexts.*", contextID),
+				),
+			},
+			{
+				Config: testAccServiceDeploymentDependencies + `
+resource "plural_service_deployment" "test" {
+  name         = "ingress"
+  namespace    = "ingress"
+  cluster      = { id = plural_cluster.test.id }
+  repository   = { id = plural_git_repository.test.id }
+  dependencies = []
+  contexts     = []
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("plural_service_deployment.test", "dependencies.#", "0"),
+					resource.TestCheckResourceAttr("plural_service_deployment.test", "contexts.#", "0"),
+					testAccCheckServiceRelationsCleared(console),
+				),
+			},
+			{
+				Config: testAccServiceDeploymentDependencies + `
+resource "plural_service_deployment" "test" {
+  name         = "ingress"
+  namespace    = "ingress"
+  cluster      = { id = plural_cluster.test.id }
+  repository   = { id = plural_git_repository.test.id }
+  dependencies = ["cert-manager"]
+  contexts     = ["shared"]
+}
+`,
+				Check: resource.TestCheckResourceAttr("plural_service_deployment.test", "dependencies.#", "1"),
+			},
+			{
+				Config: testAccServiceDeploymentDependencies + `
+resource "plural_service_deployment" "test" {
+  name       = "ingress"
+  namespace  = "ingress"
+  cluster    = { id = plural_cluster.test.id }
+  repository = { id = plural_git_repository.test.id }
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("plural_service_deployment.test", "dependencies.#"),
+					resource.TestCheckNoResourceAttr("plural_service_deployment.test", "contexts.#"),
+					testAccCheckServiceRelationsCleared(console),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckServiceRelationsCleared(console *fakeconsole.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		service := console.Get(fakeconsole.KindServiceDeployment, s.RootModule().Resources["plural_service_deployment.test"].Primary.ID)
+		if dependencies, _ := service["dependencies"].([]any); len(dependencies) != 0 {
+			return fmt.Errorf("expected service dependencies to be cleared, got %v", dependencies)
+		}
+		if bindings, _ := service["contextBindings"].([]any); len(bindings) != 0 {
+			return fmt.Errorf("expected service context bindings to be cleared, got %v", bindings)
+		}
+		return nil
+	}
+}
+
+func TestAccServiceDeploymentResourceWaitFor(t *testing.T) {
+	console := testAccConsole(t)
+
+	config := func(ref string) string {
+		return testAccServiceDeploymentDependencies + `
+resource "plural_service_deployment" "test" {
+  name       = "test"
+  namespace  = "test"
+  cluster    = { id = plural_cluster.test.id }
+  repository = {
+    id     = plural_git_repository.test.id
+    ref    = "` + ref + `"
+    folder = "charts/console"
+  }
+  wait_for = {
+    timeout       = "2s"
+    poll_interval = "500ms"
+  }
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("main"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("plural_service_deployment.test", "wait_for.status", "healthy"),
+				),
+			},
+			{
+				PreConfig: func() {
+					for _, service := range console.List(fakeconsole.KindServiceDeployment) {
+						if err := console.Set(fakeconsole.KindServiceDeployment, service["id"].(string), fakeconsole.Object{
+							"status": "STALE",
+							"components": []any{
+								fakeconsole.Object{"kind": "Deployment", "namespace": "test", "name": "console", "state": "PENDING", "synced": false},
+							},
+						}); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				Config:      config("release"),
+				ExpectError: regexp.MustCompile(`(?s)did not become\s+healthy.*Component\s+Deployment\s+test/console\s+is\s+PENDING`),
+			},
+		},
+	})
+}
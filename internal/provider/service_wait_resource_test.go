@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"terraform-provider-plural/internal/fakeconsole"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServiceWaitResource(t *testing.T) {
+	console := testAccConsole(t)
+	clusterID := console.Seed(fakeconsole.KindCluster, fakeconsole.Object{"name": "test", "handle": "test"})
+	console.Seed(fakeconsole.KindServiceDeployment, fakeconsole.Object{
+		"name":      "test",
+		"namespace": "test",
+		"clusterId": clusterID,
+		"status":    "HEALTHY",
+	})
+	console.Seed(fakeconsole.KindServiceDeployment, fakeconsole.Object{
+		"name":      "synced",
+		"namespace": "test",
+		"clusterId": clusterID,
+		"status":    "SYNCED",
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "plural_service_wait" "test" {
+  cluster = "test"
+  service = "test"
+  warmup  = "0s"
+}
+
+resource "plural_service_wait" "synced" {
+  cluster       = "test"
+  service       = "synced"
+  warmup        = "0s"
+  poll_interval = "1s"
+  statuses      = ["HEALTHY", "SYNCED"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("plural_service_wait.test", "warmup", "0s"),
+					resource.TestCheckResourceAttr("plural_service_wait.test", "duration", "10m"),
+					resource.TestCheckResourceAttr("plural_service_wait.test", "poll_interval", "30s"),
+					resource.TestCheckResourceAttr("plural_service_wait.test", "statuses.#", "1"),
+					resource.TestCheckResourceAttr("plural_service_wait.synced", "statuses.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceWaitResourceFailsFast(t *testing.T) {
+	console := testAccConsole(t)
+	clusterID := console.Seed(fakeconsole.KindCluster, fakeconsole.Object{"name": "test", "handle": "test"})
+	console.Seed(fakeconsole.KindServiceDeployment, fakeconsole.Object{
+		"name":      "test",
+		"namespace": "test",
+		"clusterId": clusterID,
+		"status":    "FAILED",
+		"errors":    []any{fakeconsole.Object{"source": "sync", "message": "invalid manifest"}},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "plural_service_wait" "test" {
+  cluster = "test"
+  service = "test"
+  warmup  = "0s"
+}
+`,
+				ExpectError: regexp.MustCompile(`(?s)has\s+failed.*invalid\s+manifest`),
+			},
+		},
+	})
+}
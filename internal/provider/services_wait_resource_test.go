@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"terraform-provider-plural/internal/fakeconsole"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServicesWaitResource(t *testing.T) {
+	console := testAccConsole(t)
+	clusterID := console.Seed(fakeconsole.KindCluster, fakeconsole.Object{"name": "test", "handle": "test"})
+	for _, name := range []string{"first", "second"} {
+		console.Seed(fakeconsole.KindServiceDeployment, fakeconsole.Object{
+			"name":      name,
+			"namespace": "test",
+			"clusterId": clusterID,
+			"status":    "HEALTHY",
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "plural_services_wait" "test" {
+  targets = [
+    { cluster = "test", service = "first" },
+    { cluster = "test", service = "second" },
+  ]
+  warmup = "0s"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("plural_services_wait.test", "targets.#", "2"),
+					resource.TestCheckResourceAttr("plural_services_wait.test", "statuses.0", "HEALTHY"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServicesWaitResourceGlobalService(t *testing.T) {
+	console := testAccConsole(t)
+	globalID := console.Seed(fakeconsole.KindGlobalService, fakeconsole.Object{"name": "global"})
+	for handle, status := range map[string]string{"healthy": "HEALTHY", "broken": "FAILED"} {
+		clusterID := console.Seed(fakeconsole.KindCluster, fakeconsole.Object{"name": handle, "handle": handle})
+		console.Seed(fakeconsole.KindServiceDeployment, fakeconsole.Object{
+			"name":      "global",
+			"namespace": "test",
+			"clusterId": clusterID,
+			"ownerId":   globalID,
+			"status":    status,
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "plural_services_wait" "test" {
+  global_service_id = "` + globalID + `"
+  warmup            = "0s"
+  poll_interval     = "1s"
+}
+`,
+				ExpectError: regexp.MustCompile(`Service\s+broken/global\s+did\s+not\s+reach`),
+			},
+		},
+	})
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-plural/internal/fakeconsole"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSharedSecretResource(t *testing.T) {
+	console := testAccConsole(t)
+	userID := console.Seed(fakeconsole.KindUser, fakeconsole.Object{"name": "recipient", "email": "recipient@example.com"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "plural_shared_secret" "test" {
+  name                  = "test"
+  secret                = "secret"
+  notification_bindings = [{ user_id = "` + userID + `" }]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("plural_shared_secret.test", "name", "test"),
+					resource.TestCheckResourceAttr("plural_shared_secret.test", "notification_bindings.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPrAutomationTriggerResource(t *testing.T) {
+	console := testAccConsole(t)
+	prAutomationID := console.Seed(fakeconsole.KindPrAutomation, fakeconsole.Object{"name": "upgrade"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "plural_pr_automation_trigger" "test" {
+  pr_automation_id     = "` + prAutomationID + `"
+  pr_automation_branch = "upgrade"
+  context              = { version = "1.0.0" }
+}
+`,
+				Check: resource.TestCheckResourceAttr("plural_pr_automation_trigger.test", "context.version", "1.0.0"),
+			},
+		},
+	})
+}
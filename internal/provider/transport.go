@@ -0,0 +1,337 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"terraform-provider-plural/internal/common"
+)
+
+const (
+	defaultMaxRetries   = 4
+	defaultRetryWaitMin = time.Second
+	defaultRetryWaitMax = 30 * time.Second
+)
+
+// authedTransport authenticates requests with a token from the token source. If the Console rejects
+// the token, it is refreshed and the request is retried once.
+type authedTransport struct {
+	tokens  tokenSource
+	wrapped http.RoundTripper
+}
+
+func (t *authedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.roundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	if (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) || !t.tokens.Invalidate() {
+		return resp, err
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+
+	return t.roundTrip(retry)
+}
+
+func (t *authedTransport) roundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.tokens.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Token "+token)
+	return t.wrapped.RoundTrip(req)
+}
+
+// headersTransport sets additional headers on every request, i.e. ones required by a proxy in front of the Console.
+type headersTransport struct {
+	headers map[string]string
+	wrapped http.RoundTripper
+}
+
+func (t *headersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for name, value := range t.headers {
+		req.Header.Set(name, value)
+	}
+
+	return t.wrapped.RoundTrip(req)
+}
+
+// readOnlyTransport rejects GraphQL mutations before they are sent to the Console. Resources check read-only
+// mode on their own, so it only guards against requests that would otherwise slip through.
+type readOnlyTransport struct {
+	wrapped http.RoundTripper
+}
+
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.GetBody != nil {
+		operation, err := graphQLOperation(req.GetBody)
+		if err != nil {
+			return nil, err
+		}
+
+		if isMutation(operation.Query) {
+			return nil, fmt.Errorf("cannot send %s mutation: %w", operation.OperationName, common.ErrReadOnly)
+		}
+	}
+
+	return t.wrapped.RoundTrip(req)
+}
+
+// graphQLOperation decodes the GraphQL operation from a request body. Bodies that are not GraphQL requests
+// result in an empty operation.
+func graphQLOperation(getBody func() (io.ReadCloser, error)) (graphQLRequest, error) {
+	body, err := getBody()
+	if err != nil {
+		return graphQLRequest{}, err
+	}
+	defer body.Close()
+
+	operation := graphQLRequest{}
+	_ = json.NewDecoder(body).Decode(&operation)
+	return operation, nil
+}
+
+func isMutation(query string) bool {
+	return strings.HasPrefix(strings.TrimSpace(query), "mutation")
+}
+
+// tlsSettings describe how to connect to the Console.
+type tlsSettings struct {
+	caCertificate      string
+	insecureSkipVerify bool
+	tlsServerName      string
+	proxyURL           string
+}
+
+// newBaseTransport returns a copy of the default transport configured with custom TLS and proxy settings.
+// If proxy URL is not set, proxy is read from the environment, i.e. HTTPS_PROXY.
+func newBaseTransport(settings tlsSettings) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		InsecureSkipVerify: settings.insecureSkipVerify, //nolint:gosec
+		ServerName:         settings.tlsServerName,
+	}
+
+	if settings.caCertificate != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM([]byte(settings.caCertificate)) {
+			return nil, fmt.Errorf("no valid PEM-encoded certificates found in CA certificate")
+		}
+
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	if settings.proxyURL != "" {
+		proxyURL, err := url.Parse(settings.proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse proxy URL: %w", err)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return transport, nil
+}
+
+// retryTransport retries requests that failed with a transient error using exponential backoff with jitter.
+// It honors the Retry-After header and, if maxInFlight is set, limits the number of concurrent requests.
+// Mutations are only retried if the Console did not process them, as they are not guaranteed to be idempotent.
+type retryTransport struct {
+	wrapped    http.RoundTripper
+	maxRetries int
+	waitMin    time.Duration
+	waitMax    time.Duration
+	inFlight   chan struct{}
+}
+
+func newRetryTransport(wrapped http.RoundTripper, maxRetries int, waitMin, waitMax time.Duration, maxInFlight int) *retryTransport {
+	t := &retryTransport{
+		wrapped:    wrapped,
+		maxRetries: maxRetries,
+		waitMin:    waitMin,
+		waitMax:    waitMax,
+	}
+
+	if maxInFlight > 0 {
+		t.inFlight = make(chan struct{}, maxInFlight)
+	}
+
+	return t
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	getBody := req.GetBody
+	if req.Body != nil && req.Body != http.NoBody && getBody == nil {
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		getBody = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(body)), nil }
+	}
+
+	mutation := false
+	if getBody != nil {
+		operation, err := graphQLOperation(getBody)
+		if err != nil {
+			return nil, err
+		}
+
+		mutation = isMutation(operation.Query)
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if getBody != nil {
+			body, err := getBody()
+			if err != nil {
+				return nil, err
+			}
+
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		resp, err := t.roundTrip(attemptReq)
+		if attempt >= t.maxRetries || !retryable(req.Context(), resp, err, mutation) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+func (t *retryTransport) roundTrip(req *http.Request) (*http.Response, error) {
+	if t.inFlight != nil {
+		select {
+		case t.inFlight <- struct{}{}:
+			defer func() { <-t.inFlight }()
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	return t.wrapped.RoundTrip(req)
+}
+
+// backoff returns the time to wait before the next attempt. It is the larger of the Retry-After
+// header value and the exponential backoff with full jitter, capped at waitMax.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	wait := time.Duration(float64(t.waitMin) * math.Pow(2, float64(attempt)))
+	if wait <= 0 || wait > t.waitMax {
+		wait = t.waitMax
+	}
+
+	if wait > t.waitMin {
+		wait = t.waitMin + rand.N(wait-t.waitMin+1)
+	}
+
+	if retryAfter := retryAfter(resp); retryAfter > wait {
+		return retryAfter
+	}
+
+	return wait
+}
+
+// retryable checks if a request should be retried. Rate-limited and gateway errors are retried,
+// as well as transport errors unless the request context has been canceled. Mutations are retried
+// only if they were rejected before being processed or could not reach the Console at all.
+func retryable(ctx context.Context, resp *http.Response, err error, mutation bool) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		if mutation {
+			return notSent(err)
+		}
+
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	if mutation {
+		return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// notSent checks if a transport error happened before the request was written, i.e. the connection was refused.
+func notSent(err error) bool {
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+
+	opErr := new(net.OpError)
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// retryAfter parses the Retry-After header, which can either be a number of seconds or an HTTP date.
+func retryAfter(resp *http.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		return time.Until(date)
+	}
+
+	return 0
+}
@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"terraform-provider-plural/internal/common"
+)
+
+func TestRetryTransportRetriesTransientErrors(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "query" {
+			t.Errorf("expected request body to be replayed, got %q", body)
+		}
+
+		switch attempts.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusBadGateway)
+		case 2:
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	client := http.Client{Transport: newRetryTransport(http.DefaultTransport, 3, time.Millisecond, 10*time.Millisecond, 0)}
+
+	start := time.Now()
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader("query"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", resp.StatusCode)
+	}
+
+	if attempts.Load() != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts.Load())
+	}
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected Retry-After to be honored, request took %s", elapsed)
+	}
+}
+
+func TestRetryTransportGivesUp(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := http.Client{Transport: newRetryTransport(http.DefaultTransport, 2, time.Millisecond, time.Millisecond, 0)}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader("query"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected status 503, got %d", resp.StatusCode)
+	}
+
+	if attempts.Load() != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts.Load())
+	}
+}
+
+func TestRetryTransportDoesNotRetryClientErrors(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := http.Client{Transport: newRetryTransport(http.DefaultTransport, 3, time.Millisecond, time.Millisecond, 0)}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader("query"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if attempts.Load() != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempts.Load())
+	}
+}
+
+func TestRetryTransportLimitsInFlightRequests(t *testing.T) {
+	var current, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := current.Add(1)
+		defer current.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := http.Client{Transport: newRetryTransport(http.DefaultTransport, 0, time.Millisecond, time.Millisecond, 2)}
+
+	done := make(chan struct{})
+	for range 6 {
+		go func() {
+			defer func() { done <- struct{}{} }()
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			_ = resp.Body.Close()
+		}()
+	}
+
+	for range 6 {
+		<-done
+	}
+
+	if peak.Load() > 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", peak.Load())
+	}
+}
+
+func TestBaseTransportTrustsCACertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Gateway") != "plural" {
+			t.Errorf("expected X-Gateway header to be set, got %q", r.Header.Get("X-Gateway"))
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	caCertificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	if _, err := (&http.Client{Transport: http.DefaultTransport}).Get(server.URL); err == nil {
+		t.Fatal("expected default transport to reject the test server certificate")
+	}
+
+	transport, err := newBaseTransport(tlsSettings{caCertificate: string(caCertificate)})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := http.Client{Transport: &headersTransport{headers: map[string]string{"X-Gateway": "plural"}, wrapped: transport}}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	_ = resp.Body.Close()
+}
+
+func TestBaseTransportRejectsInvalidCACertificate(t *testing.T) {
+	if _, err := newBaseTransport(tlsSettings{caCertificate: "invalid"}); err == nil {
+		t.Error("expected invalid CA certificate to be rejected")
+	}
+}
+
+func TestReadOnlyTransportRejectsMutations(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write([]byte(`{"data": {}}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &readOnlyTransport{wrapped: http.DefaultTransport}}
+	query := `{"operationName": "GetCluster", "query": "query GetCluster { cluster { id } }"}`
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(query))
+	if err != nil {
+		t.Fatalf("expected query to be sent, got error: %s", err)
+	}
+	_ = resp.Body.Close()
+
+	mutation := `{"operationName": "DeleteCluster", "query": "\n mutation DeleteCluster { deleteCluster { id } }"}`
+	if _, err = client.Post(server.URL, "application/json", strings.NewReader(mutation)); !errors.Is(err, common.ErrReadOnly) {
+		t.Errorf("expected mutation to be rejected, got %v", err)
+	}
+
+	if requests.Load() != 1 {
+		t.Errorf("expected only the query to reach the Console, got %d requests", requests.Load())
+	}
+}
+
+func TestRetryTransportRetriesMutationsOnlyIfNotProcessed(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		switch attempts.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer server.Close()
+
+	client := http.Client{Transport: newRetryTransport(http.DefaultTransport, 3, time.Millisecond, time.Millisecond, 0)}
+
+	mutation := `{"operationName": "CreateCluster", "query": "mutation CreateCluster { createCluster { id } }"}`
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(mutation))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadGateway {
+		t.Errorf("expected status 502, got %d", resp.StatusCode)
+	}
+
+	if attempts.Load() != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts.Load())
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	ctx := context.Background()
+	refused := &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+	reset := &url.Error{Op: "Post", URL: "https://console", Err: &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}}
+
+	cases := []struct {
+		name     string
+		resp     *http.Response
+		err      error
+		mutation bool
+		expected bool
+	}{
+		{name: "query connection refused", err: refused, expected: true},
+		{name: "query connection reset", err: reset, expected: true},
+		{name: "query gateway timeout", resp: &http.Response{StatusCode: http.StatusGatewayTimeout}, expected: true},
+		{name: "mutation connection refused", err: refused, mutation: true, expected: true},
+		{name: "mutation connection reset", err: reset, mutation: true, expected: false},
+		{name: "mutation rate limited", resp: &http.Response{StatusCode: http.StatusTooManyRequests}, mutation: true, expected: true},
+		{name: "mutation unavailable", resp: &http.Response{StatusCode: http.StatusServiceUnavailable}, mutation: true, expected: true},
+		{name: "mutation gateway timeout", resp: &http.Response{StatusCode: http.StatusGatewayTimeout}, mutation: true, expected: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if actual := retryable(ctx, c.resp, c.err, c.mutation); actual != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
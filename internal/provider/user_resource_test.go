@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-plural/internal/fakeconsole"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserResource(t *testing.T) {
+	console := testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "plural_user" "test" {
+  name  = "Test User"
+  email = "test@example.com"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExists(console, fakeconsole.KindUser, "plural_user.test"),
+					resource.TestCheckResourceAttr("plural_user.test", "name", "Test User"),
+					resource.TestCheckResourceAttr("plural_user.test", "email", "test@example.com"),
+				),
+			},
+			{
+				ResourceName:                         "plural_user.test",
+				ImportState:                          true,
+				ImportStateId:                        "test@example.com",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "email",
+			},
+			{
+				Config: `
+resource "plural_user" "test" {
+  name  = "Renamed User"
+  email = "test@example.com"
+}
+`,
+				Check: resource.TestCheckResourceAttr("plural_user.test", "name", "Renamed User"),
+			},
+		},
+	})
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-plural/internal/fakeconsole"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSCMWebhookResource(t *testing.T) {
+	console := testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(console, fakeconsole.KindScmWebhook),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "plural_scm_webhook" "test" {
+  owner = "pluralsh"
+  type  = "GITHUB"
+  hmac  = "secret"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExists(console, fakeconsole.KindScmWebhook, "plural_scm_webhook.test"),
+					resource.TestCheckResourceAttrSet("plural_scm_webhook.test", "url"),
+					resource.TestCheckResourceAttr("plural_scm_webhook.test", "owner", "pluralsh"),
+				),
+			},
+			{
+				ResourceName:            "plural_scm_webhook.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"hmac"},
+			},
+		},
+	})
+}
+
+func TestAccObservabilityWebhookResource(t *testing.T) {
+	console := testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(console, fakeconsole.KindObservabilityWebhook),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "plural_observability_webhook" "test" {
+  name   = "test"
+  type   = "GRAFANA"
+  secret = "secret"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExists(console, fakeconsole.KindObservabilityWebhook, "plural_observability_webhook.test"),
+					resource.TestCheckResourceAttrSet("plural_observability_webhook.test", "url"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"terraform-provider-plural/internal/fakeconsole"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccWorkbenchConfig = `
+resource "plural_workbench_tool" "test" {
+  name = "prometheus"
+  tool = "PROMETHEUS"
+  configuration = {
+    prometheus = { url = "https://prometheus.example.com" }
+  }
+}
+
+resource "plural_workbench" "test" {
+  name          = "test"
+  description   = "test workbench"
+  system_prompt = "You are a helpful assistant."
+  tool_ids      = [plural_workbench_tool.test.id]
+}
+`
+
+func TestAccWorkbenchResource(t *testing.T) {
+	console := testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckDestroyed(console, fakeconsole.KindWorkbench),
+			testAccCheckDestroyed(console, fakeconsole.KindWorkbenchTool),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkbenchConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExists(console, fakeconsole.KindWorkbench, "plural_workbench.test"),
+					testAccCheckExists(console, fakeconsole.KindWorkbenchTool, "plural_workbench_tool.test"),
+					resource.TestCheckResourceAttr("plural_workbench.test", "tool_ids.#", "1"),
+					resource.TestCheckResourceAttr("plural_workbench_tool.test", "configuration.prometheus.url", "https://prometheus.example.com"),
+				),
+			},
+			{
+				ResourceName:      "plural_workbench.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "plural_workbench_tool.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkbenchTriggersResource(t *testing.T) {
+	console := testAccConsole(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckDestroyed(console, fakeconsole.KindWorkbenchCron),
+			testAccCheckDestroyed(console, fakeconsole.KindWorkbenchWebhook),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkbenchConfig + `
+resource "plural_observability_webhook" "test" {
+  name = "test"
+  type = "GRAFANA"
+}
+
+resource "plural_workbench_cron" "test" {
+  workbench_id = plural_workbench.test.id
+  crontab      = "0 * * * *"
+  prompt       = "Summarize alerts."
+}
+
+resource "plural_workbench_webhook" "test" {
+  workbench_id = plural_workbench.test.id
+  name         = "alerts"
+  webhook_id   = plural_observability_webhook.test.id
+  matches      = { substring = "critical", case_insensitive = true }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExists(console, fakeconsole.KindWorkbenchCron, "plural_workbench_cron.test"),
+					testAccCheckExists(console, fakeconsole.KindWorkbenchWebhook, "plural_workbench_webhook.test"),
+					resource.TestCheckResourceAttrPair("plural_workbench_cron.test", "workbench_id", "plural_workbench.test", "id"),
+					resource.TestCheckResourceAttrPair("plural_workbench_webhook.test", "webhook_id", "plural_observability_webhook.test", "id"),
+					resource.TestCheckResourceAttr("plural_workbench_webhook.test", "matches.substring", "critical"),
+				),
+			},
+			{
+				ResourceName:      "plural_workbench_cron.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "plural_workbench_webhook.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkbenchResourceUnsupportedConsole(t *testing.T) {
+	console := testAccConsole(t)
+	console.Seed(fakeconsole.KindConfiguration, fakeconsole.Object{"consoleVersion": "0.10.0"})
+	for _, field := range []string{"createWorkbench", "createWorkbenchTool"} {
+		console.Remove(field)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccWorkbenchConfig,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Workbenches are not supported by the Console \(version 0\.10\.0\)`),
+			},
+		},
+	})
+}
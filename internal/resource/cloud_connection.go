@@ -19,6 +19,7 @@ import (
 
 var _ resource.Resource = &CloudConnectionResource{}
 var _ resource.ResourceWithImportState = &CloudConnectionResource{}
+var _ resource.ResourceWithModifyPlan = &CloudConnectionResource{}
 
 func NewCloudConnectionResource() resource.Resource {
 	return &CloudConnectionResource{}
@@ -135,7 +136,15 @@ func (r *CloudConnectionResource) Configure(_ context.Context, req resource.Conf
 	r.client = data.Client
 }
 
+func (r *CloudConnectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanRequireFeature(ctx, r.client, client.FeatureCloudConnections, req, resp)
+}
+
 func (r *CloudConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if common.CheckReadOnly(r.client, "create", &resp.Diagnostics) {
+		return
+	}
+
 	var data model.CloudConnection
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -144,7 +153,7 @@ func (r *CloudConnectionResource) Create(ctx context.Context, req resource.Creat
 
 	result, err := r.client.UpsertCloudConnection(ctx, data.Attributes(ctx, &resp.Diagnostics))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create cloud connection, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create cloud connection", err)
 		return
 	}
 
@@ -175,6 +184,10 @@ func (r *CloudConnectionResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *CloudConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if common.CheckReadOnly(r.client, "update", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.CloudConnection)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -185,7 +198,7 @@ func (r *CloudConnectionResource) Update(ctx context.Context, req resource.Updat
 
 	_, err := r.client.UpsertCloudConnection(ctx, attr)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update cloud connection, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update cloud connection", err)
 		return
 	}
 
@@ -193,6 +206,10 @@ func (r *CloudConnectionResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *CloudConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if common.CheckReadOnly(r.client, "delete", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.CloudConnection)
 	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
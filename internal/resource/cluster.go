@@ -3,11 +3,12 @@ package resource
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
-	"time"
 
 	"terraform-provider-plural/internal/client"
 	"terraform-provider-plural/internal/common"
+	"terraform-provider-plural/internal/model"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,6 +27,7 @@ import (
 var _ resource.Resource = &clusterResource{}
 var _ resource.ResourceWithImportState = &clusterResource{}
 var _ resource.ResourceWithUpgradeState = &clusterResource{}
+var _ resource.ResourceWithModifyPlan = &clusterResource{}
 
 func NewClusterResource() resource.Resource {
 	return &clusterResource{}
@@ -36,14 +38,16 @@ type clusterResource struct {
 	client     *client.Client
 	consoleUrl string
 	kubeClient *common.KubeClient
+	httpClient *http.Client
+	defaults   *common.Defaults
 }
 
 func (r *clusterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_cluster"
 }
 
-func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = r.schema()
+func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = r.schema(ctx)
 }
 
 func (r *clusterResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -63,26 +67,100 @@ func (r *clusterResource) Configure(_ context.Context, req resource.ConfigureReq
 	r.client = data.Client
 	r.consoleUrl = data.ConsoleUrl
 	r.kubeClient = data.KubeClient
+	r.httpClient = data.HTTPClient
+	r.defaults = data.Defaults
+}
+
+func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultProject(ctx, r.defaults, req, resp)
+	modifyPlanDefaultTags(ctx, r.defaults, req, resp)
+	r.modifyPlanUpgrade(ctx, req, resp)
+	r.modifyPlanHelmRepoPassword(ctx, req, resp)
+}
+
+// modifyPlanHelmRepoPassword plans the hash of the write-only helm_repo_password, so that password changes show up in the plan.
+func (r *clusterResource) modifyPlanHelmRepoPassword(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("helm_repo_password"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("helm_repo_password_hash"), model.SecretHashFrom(password))...)
+}
+
+// modifyPlanUpgrade marks current_version as unknown when the desired version changes and refuses
+// the plan if Console upgrade preflight checks report any blockers.
+func (r *clusterResource) modifyPlanUpgrade(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state cluster
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Version.IsUnknown() || plan.Version.IsNull() || plan.Version.Equal(state.Version) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("current_version"), types.StringUnknown())...)
+	if r.client == nil {
+		return
+	}
+
+	upgrade, err := r.client.GetClusterUpgrade(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning("Client Error", fmt.Sprintf("Unable to run upgrade preflight checks, got error: %s", err))
+		return
+	}
+
+	if blockers := upgrade.UpgradePlan.Blockers(); len(blockers) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("version"),
+			"Cluster Upgrade Blocked",
+			fmt.Sprintf("Unable to upgrade cluster to version %s, upgrade preflight checks report blockers: %s. Resolve them in the Console before upgrading.",
+				plan.Version.ValueString(), strings.Join(blockers, ", ")),
+		)
+	}
 }
 
 func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if common.CheckReadOnly(r.client, "create", &resp.Diagnostics) {
+		return
+	}
+
 	var data cluster
+	var helmRepoPassword types.String
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("helm_repo_password"), &helmRepoPassword)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, clusterDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	result, err := r.client.CreateCluster(ctx, data.Attributes(ctx, &resp.Diagnostics))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create cluster, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create cluster", err)
 		return
 	}
 	data.FromCreate(result, ctx, &resp.Diagnostics)
 
 	if r.kubeClient != nil || data.HasKubeconfig() {
-		err = InstallOrUpgradeAgent(ctx, r.client, data.GetKubeconfig(), r.kubeClient, data.HelmRepoUrl.ValueString(),
+		err = InstallOrUpgradeAgent(ctx, r.client, r.httpClient, data.GetKubeconfig(), r.kubeClient, data.AgentChart(helmRepoPassword),
 			data.HelmValues.ValueStringPointer(), r.consoleUrl, lo.FromPtr(result.CreateCluster.DeployToken),
-			result.CreateCluster.ID, &resp.Diagnostics)
+			result.CreateCluster.ID, data.AgentWait.ValueBool(), &resp.Diagnostics)
 		if err != nil {
 			resp.Diagnostics.AddWarning("Agent Installation Failed", fmt.Sprintf(
 				"Unable to install agent, in order to retry run `terraform apply` again. Got error: %s", err))
@@ -112,7 +190,7 @@ func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		data.From(result.Cluster, ctx, &resp.Diagnostics)
+		data.From(result.Cluster, r.defaults, ctx, &resp.Diagnostics)
 	} else if !data.Handle.IsNull() {
 		result, err := r.client.GetClusterByHandle(ctx, data.Handle.ValueStringPointer())
 		if err != nil && !client.IsNotFound(err) {
@@ -124,20 +202,35 @@ func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		data.From(result.Cluster, ctx, &resp.Diagnostics)
+		data.From(result.Cluster, r.defaults, ctx, &resp.Diagnostics)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if common.CheckReadOnly(r.client, "update", &resp.Diagnostics) {
+		return
+	}
+
 	var data, state cluster
+	var helmRepoPassword types.String
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("helm_repo_password"), &helmRepoPassword)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, clusterDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	if !data.ProjectId.Equal(state.ProjectId) && !data.ProjectId.IsNull() {
 		resp.Diagnostics.AddError("Invalid Configuration", "Unable to update cluster, project ID must not be modified")
 		return
@@ -145,10 +238,29 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	result, err := r.client.UpdateCluster(ctx, data.Id.ValueString(), data.UpdateAttributes(ctx, &resp.Diagnostics))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update cluster, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update cluster", err)
 		return
 	}
 
+	if data.Version.IsUnknown() {
+		data.Version = types.StringPointerValue(result.UpdateCluster.Version)
+	}
+	if data.CurrentVersion.IsUnknown() {
+		data.CurrentVersion = types.StringPointerValue(result.UpdateCluster.CurrentVersion)
+	}
+
+	if data.WaitForUpgrade.ValueBool() && !data.Version.IsNull() && !data.Version.Equal(state.Version) {
+		upgrade, err := r.waitForUpgrade(ctx, data.Id.ValueString(), data.Version.ValueString())
+		if upgrade != nil {
+			data.CurrentVersion = types.StringPointerValue(upgrade.CurrentVersion)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error while waiting for cluster to be upgraded to version %s, got error: %s", data.Version.ValueString(), err))
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
 	// The plan may leave agent_deployed unknown even when state is true; use
 	// state so the value written back after apply is always known.
 	if data.AgentDeployed.IsNull() || data.AgentDeployed.IsUnknown() {
@@ -159,7 +271,8 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	kubeconfigChanged := data.HasKubeconfig() && !data.GetKubeconfig().Unchanged(state.GetKubeconfig())
-	reinstallable := !data.AgentDeployed.ValueBool() || !data.HelmRepoUrl.Equal(state.HelmRepoUrl) || kubeconfigChanged
+	chartChanged := !data.HelmRepoUrl.Equal(state.HelmRepoUrl) || !data.HelmChartPath.Equal(state.HelmChartPath) || !data.AgentVersion.Equal(state.AgentVersion)
+	reinstallable := !data.AgentDeployed.ValueBool() || chartChanged || kubeconfigChanged
 	if reinstallable && (r.kubeClient != nil || data.HasKubeconfig()) {
 		clusterWithToken, err := r.client.GetClusterWithToken(ctx, data.Id.ValueStringPointer(), nil)
 		if err != nil {
@@ -167,8 +280,9 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 			return
 		}
 
-		if err = InstallOrUpgradeAgent(ctx, r.client, data.GetKubeconfig(), r.kubeClient, data.HelmRepoUrl.ValueString(),
-			data.HelmValues.ValueStringPointer(), r.consoleUrl, lo.FromPtr(clusterWithToken.Cluster.DeployToken), result.UpdateCluster.ID, &resp.Diagnostics); err != nil {
+		if err = InstallOrUpgradeAgent(ctx, r.client, r.httpClient, data.GetKubeconfig(), r.kubeClient, data.AgentChart(helmRepoPassword),
+			data.HelmValues.ValueStringPointer(), r.consoleUrl, lo.FromPtr(clusterWithToken.Cluster.DeployToken), result.UpdateCluster.ID,
+			data.AgentWait.ValueBool(), &resp.Diagnostics); err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to install operator, got error: %s", err))
 			return
 		}
@@ -179,25 +293,54 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// waitForUpgrade polls the cluster until it runs the desired version. It returns the last fetched cluster version.
+func (r *clusterResource) waitForUpgrade(ctx context.Context, id, version string) (*client.ClusterUpgrade, error) {
+	var upgrade *client.ClusterUpgrade
+	err := wait.PollUntilContextCancel(ctx, pollInterval, true, func(ctx context.Context) (bool, error) {
+		result, err := r.client.GetClusterUpgrade(ctx, id)
+		if err != nil {
+			return false, err
+		}
+
+		upgrade = result
+		return upgrade.Upgraded(version), nil
+	})
+
+	return upgrade, err
+}
+
 func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if common.CheckReadOnly(r.client, "delete", &resp.Diagnostics) {
+		return
+	}
+
 	var data cluster
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteCtx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if data.Detach.ValueBool() {
-		if _, err := r.client.DetachCluster(ctx, data.Id.ValueString()); err != nil && !client.IsNotFound(err) {
+		if _, err := r.client.DetachCluster(deleteCtx, data.Id.ValueString()); err != nil && !client.IsNotFound(err) {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to detach cluster, got error: %s", err))
 			return
 		}
 	} else {
-		if _, err := r.client.DeleteCluster(ctx, data.Id.ValueString()); err != nil && !client.IsNotFound(err) {
+		if _, err := r.client.DeleteCluster(deleteCtx, data.Id.ValueString()); err != nil && !client.IsNotFound(err) {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete cluster, got error: %s", err))
 			return
 		}
 
-		if err := wait.PollUntilContextTimeout(ctx, 10*time.Second, 10*time.Minute, true, func(ctx context.Context) (bool, error) {
+		if pollErr := wait.PollUntilContextCancel(deleteCtx, pollInterval, true, func(ctx context.Context) (bool, error) {
 			response, err := r.client.GetCluster(ctx, data.Id.ValueStringPointer())
 			if client.IsNotFound(err) {
 				return true, nil
@@ -208,14 +351,34 @@ func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 			}
 
 			return false, err
-		}); err != nil {
-			resp.Diagnostics.AddWarning("Client Error", fmt.Sprintf("Error while watiting for cluster to be deleted, got error: %s", err))
+		}); pollErr != nil {
+			if !data.DetachOnDeleteTimeout.ValueBool() {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error while waiting for cluster to be deleted, got error: %s. "+
+					"Increase the delete timeout or set detach_on_delete_timeout to detach the cluster instead.", pollErr))
+				return
+			}
 
-			_, err = r.client.DetachCluster(ctx, data.Id.ValueString())
-			if err != nil {
+			// The delete timeout is exhausted at this point, so the fallback uses the operation context.
+			if _, err := r.client.DetachCluster(ctx, data.Id.ValueString()); err != nil && !client.IsNotFound(err) {
 				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to detach cluster, got error: %s", err))
 				return
 			}
+
+			resp.Diagnostics.AddWarning("Cluster Detached", fmt.Sprintf("Cluster was not deleted before the delete timeout, got error: %s. "+
+				"It was detached instead, cloud resources and the deployment agent may still be running.", pollErr))
+			return
+		}
+	}
+
+	if data.UninstallAgentOnDestroy.ValueBool() {
+		if r.kubeClient == nil && !data.HasKubeconfig() {
+			resp.Diagnostics.AddWarning("Agent Uninstallation Skipped", "Unable to uninstall agent, kubeconfig is not configured on the provider or cluster level.")
+			return
+		}
+
+		if err := UninstallAgent(deleteCtx, r.client, data.GetKubeconfig(), r.kubeClient, data.RemoveAgentNamespace.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to uninstall agent, got error: %s", err))
+			return
 		}
 	}
 }
@@ -345,20 +508,35 @@ func (r *clusterResource) UpgradeState(_ context.Context) map[int64]resource.Sta
 				}
 
 				upgradedStateData := cluster{
-					Id:            priorStateData.Id,
-					InsertedAt:    priorStateData.InsertedAt,
-					Name:          priorStateData.Name,
-					Handle:        priorStateData.Handle,
-					ProjectId:     priorStateData.ProjectId,
-					Detach:        priorStateData.Detach,
-					Protect:       priorStateData.Protect,
-					Tags:          priorStateData.Tags,
-					Metadata:      priorStateData.Metadata,
-					Bindings:      priorStateData.Bindings,
-					HelmRepoUrl:   priorStateData.HelmRepoUrl,
-					HelmValues:    priorStateData.HelmValues,
-					Kubeconfig:    priorStateData.Kubeconfig,
-					AgentDeployed: types.BoolValue(true),
+					Id:                      priorStateData.Id,
+					InsertedAt:              priorStateData.InsertedAt,
+					Name:                    priorStateData.Name,
+					Handle:                  priorStateData.Handle,
+					ProjectId:               priorStateData.ProjectId,
+					Detach:                  priorStateData.Detach,
+					DetachOnDeleteTimeout:   types.BoolValue(true),
+					UninstallAgentOnDestroy: types.BoolValue(false),
+					RemoveAgentNamespace:    types.BoolValue(false),
+					Protect:                 priorStateData.Protect,
+					Tags:                    priorStateData.Tags,
+					TagsAll:                 priorStateData.Tags,
+					Metadata:                priorStateData.Metadata,
+					Version:                 types.StringNull(),
+					CurrentVersion:          types.StringNull(),
+					WaitForUpgrade:          types.BoolValue(false),
+					NodePools:               types.MapNull(types.ObjectType{AttrTypes: common.ClusterNodePoolAttrTypes}),
+					Bindings:                priorStateData.Bindings,
+					HelmRepoUrl:             priorStateData.HelmRepoUrl,
+					HelmRepoUsername:        types.StringNull(),
+					HelmRepoPassword:        types.StringNull(),
+					HelmRepoPasswordHash:    types.StringNull(),
+					HelmChartPath:           types.StringNull(),
+					AgentVersion:            types.StringNull(),
+					HelmValues:              priorStateData.HelmValues,
+					AgentWait:               types.BoolValue(false),
+					Kubeconfig:              priorStateData.Kubeconfig,
+					AgentDeployed:           types.BoolValue(true),
+					Timeouts:                timeoutsNull(),
 				}
 
 				resp.Diagnostics.Append(resp.State.Set(ctx, upgradedStateData)...)
@@ -7,36 +7,53 @@ import (
 
 	"terraform-provider-plural/internal/common"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	console "github.com/pluralsh/console/go/client"
 )
 
 type cluster struct {
-	Id            types.String       `tfsdk:"id"`
-	InsertedAt    types.String       `tfsdk:"inserted_at"`
-	Name          types.String       `tfsdk:"name"`
-	Handle        types.String       `tfsdk:"handle"`
-	ProjectId     types.String       `tfsdk:"project_id"`
-	Detach        types.Bool         `tfsdk:"detach"`
-	Protect       types.Bool         `tfsdk:"protect"`
-	Tags          types.Map          `tfsdk:"tags"`
-	Metadata      types.String       `tfsdk:"metadata"`
-	Bindings      *common.Bindings   `tfsdk:"bindings"`
-	HelmRepoUrl   types.String       `tfsdk:"helm_repo_url"`
-	HelmValues    types.String       `tfsdk:"helm_values"`
-	Kubeconfig    *common.Kubeconfig `tfsdk:"kubeconfig"`
-	AgentDeployed types.Bool         `tfsdk:"agent_deployed"`
+	Id                      types.String       `tfsdk:"id"`
+	InsertedAt              types.String       `tfsdk:"inserted_at"`
+	Name                    types.String       `tfsdk:"name"`
+	Handle                  types.String       `tfsdk:"handle"`
+	ProjectId               types.String       `tfsdk:"project_id"`
+	Detach                  types.Bool         `tfsdk:"detach"`
+	DetachOnDeleteTimeout   types.Bool         `tfsdk:"detach_on_delete_timeout"`
+	UninstallAgentOnDestroy types.Bool         `tfsdk:"uninstall_agent_on_destroy"`
+	RemoveAgentNamespace    types.Bool         `tfsdk:"remove_agent_namespace"`
+	Protect                 types.Bool         `tfsdk:"protect"`
+	Tags                    types.Map          `tfsdk:"tags"`
+	TagsAll                 types.Map          `tfsdk:"tags_all"`
+	Metadata                types.String       `tfsdk:"metadata"`
+	Version                 types.String       `tfsdk:"version"`
+	CurrentVersion          types.String       `tfsdk:"current_version"`
+	WaitForUpgrade          types.Bool         `tfsdk:"wait_for_upgrade"`
+	NodePools               types.Map          `tfsdk:"node_pools"`
+	Bindings                *common.Bindings   `tfsdk:"bindings"`
+	HelmRepoUrl             types.String       `tfsdk:"helm_repo_url"`
+	HelmRepoUsername        types.String       `tfsdk:"helm_repo_username"`
+	HelmRepoPassword        types.String       `tfsdk:"helm_repo_password"`
+	HelmRepoPasswordHash    types.String       `tfsdk:"helm_repo_password_hash"`
+	HelmChartPath           types.String       `tfsdk:"helm_chart_path"`
+	AgentVersion            types.String       `tfsdk:"agent_version"`
+	HelmValues              types.String       `tfsdk:"helm_values"`
+	AgentWait               types.Bool         `tfsdk:"agent_wait"`
+	Kubeconfig              *common.Kubeconfig `tfsdk:"kubeconfig"`
+	AgentDeployed           types.Bool         `tfsdk:"agent_deployed"`
+	Timeouts                timeouts.Value     `tfsdk:"timeouts"`
 }
 
 func (c *cluster) TagsAttribute(ctx context.Context, d *diag.Diagnostics) []*console.TagAttributes {
-	if c.Tags.IsNull() {
+	if c.TagsAll.IsNull() {
 		return nil
 	}
 
 	result := make([]*console.TagAttributes, 0)
-	elements := make(map[string]types.String, len(c.Tags.Elements()))
-	d.Append(c.Tags.ElementsAs(ctx, &elements, false)...)
+	elements := make(map[string]types.String, len(c.TagsAll.Elements()))
+	d.Append(c.TagsAll.ElementsAs(ctx, &elements, false)...)
 
 	for k, v := range elements {
 		result = append(result, &console.TagAttributes{Name: k, Value: v.ValueString()})
@@ -45,30 +62,61 @@ func (c *cluster) TagsAttribute(ctx context.Context, d *diag.Diagnostics) []*con
 	return result
 }
 
+func (c *cluster) NodePoolsAttribute(ctx context.Context, d *diag.Diagnostics) []*console.NodePoolAttributes {
+	if c.NodePools.IsNull() || c.NodePools.IsUnknown() {
+		return nil
+	}
+
+	result := make([]*console.NodePoolAttributes, 0, len(c.NodePools.Elements()))
+	nodePools := make(map[string]common.ClusterNodePool, len(c.NodePools.Elements()))
+	d.Append(c.NodePools.ElementsAs(ctx, &nodePools, false)...)
+
+	for _, nodePool := range nodePools {
+		var cloudSettings *common.NodePoolCloudSettings
+		d.Append(nodePool.CloudSettings.As(ctx, &cloudSettings, basetypes.ObjectAsOptions{})...)
+
+		result = append(result, &console.NodePoolAttributes{
+			Name:          nodePool.Name.ValueString(),
+			MinSize:       nodePool.MinSize.ValueInt64(),
+			MaxSize:       nodePool.MaxSize.ValueInt64(),
+			InstanceType:  nodePool.InstanceType.ValueString(),
+			Labels:        nodePool.LabelsAttribute(ctx, d),
+			Taints:        nodePool.TaintsAttribute(ctx, d),
+			CloudSettings: cloudSettings.Attributes(),
+		})
+	}
+
+	return result
+}
+
 func (c *cluster) Attributes(ctx context.Context, d *diag.Diagnostics) console.ClusterAttributes {
 	return console.ClusterAttributes{
 		Name:          c.Name.ValueString(),
 		Handle:        c.Handle.ValueStringPointer(),
-		ProjectID:     c.ProjectId.ValueStringPointer(),
+		ProjectID:     knownStringPointer(c.ProjectId),
 		Protect:       c.Protect.ValueBoolPointer(),
 		ReadBindings:  c.Bindings.ReadAttributes(ctx, d),
 		WriteBindings: c.Bindings.WriteAttributes(ctx, d),
 		Tags:          c.TagsAttribute(ctx, d),
 		Metadata:      c.Metadata.ValueStringPointer(),
+		Version:       knownStringPointer(c.Version),
+		NodePools:     c.NodePoolsAttribute(ctx, d),
 	}
 }
 
 func (c *cluster) UpdateAttributes(ctx context.Context, d *diag.Diagnostics) console.ClusterUpdateAttributes {
 	return console.ClusterUpdateAttributes{
-		Name:     c.Name.ValueStringPointer(),
-		Handle:   c.Handle.ValueStringPointer(),
-		Protect:  c.Protect.ValueBoolPointer(),
-		Metadata: c.Metadata.ValueStringPointer(),
-		Tags:     c.TagsAttribute(ctx, d),
+		Name:      c.Name.ValueStringPointer(),
+		Handle:    c.Handle.ValueStringPointer(),
+		Protect:   c.Protect.ValueBoolPointer(),
+		Metadata:  c.Metadata.ValueStringPointer(),
+		Tags:      c.TagsAttribute(ctx, d),
+		Version:   knownStringPointer(c.Version),
+		NodePools: c.NodePoolsAttribute(ctx, d),
 	}
 }
 
-func (c *cluster) From(cl *console.ClusterFragment, _ context.Context, d *diag.Diagnostics) {
+func (c *cluster) From(cl *console.ClusterFragment, defaults *common.Defaults, ctx context.Context, d *diag.Diagnostics) {
 	metadata, err := json.Marshal(cl.Metadata)
 	if err != nil {
 		d.AddError("Provider Error", fmt.Sprintf("Cannot marshall metadata, got error: %s", err))
@@ -80,8 +128,12 @@ func (c *cluster) From(cl *console.ClusterFragment, _ context.Context, d *diag.D
 	c.Name = types.StringValue(cl.Name)
 	c.Handle = types.StringPointerValue(cl.Handle)
 	c.Protect = types.BoolPointerValue(cl.Protect)
-	c.Tags = common.TagsFrom(cl.Tags, c.Tags, d)
+	c.TagsAll = common.TagsFrom(cl.Tags, c.TagsAll, d)
+	c.Tags = defaults.TagsWithoutDefaults(c.TagsAll, c.Tags, d)
 	c.Metadata = types.StringValue(string(metadata))
+	c.Version = types.StringPointerValue(cl.Version)
+	c.CurrentVersion = types.StringPointerValue(cl.CurrentVersion)
+	c.NodePools = nodePoolsFrom(cl.NodePools, c.NodePools, ctx, d)
 
 	if cl.Project != nil && cl.Project.ID != "" {
 		c.ProjectId = types.StringValue(cl.Project.ID)
@@ -92,14 +144,48 @@ func (c *cluster) From(cl *console.ClusterFragment, _ context.Context, d *diag.D
 	}
 }
 
-func (c *cluster) FromCreate(cc *console.CreateCluster, _ context.Context, d *diag.Diagnostics) {
+func (c *cluster) FromCreate(cc *console.CreateCluster, ctx context.Context, d *diag.Diagnostics) {
 	c.Id = types.StringValue(cc.CreateCluster.ID)
 	c.InsertedAt = types.StringPointerValue(cc.CreateCluster.InsertedAt)
 	c.Name = types.StringValue(cc.CreateCluster.Name)
 	c.Handle = types.StringPointerValue(cc.CreateCluster.Handle)
 	c.Protect = types.BoolPointerValue(cc.CreateCluster.Protect)
-	c.Tags = common.TagsFrom(cc.CreateCluster.Tags, c.Tags, d)
+	c.TagsAll = common.TagsFrom(cc.CreateCluster.Tags, c.TagsAll, d)
+	c.NodePools = nodePoolsFrom(cc.CreateCluster.NodePools, c.NodePools, ctx, d)
+	c.CurrentVersion = types.StringPointerValue(cc.CreateCluster.CurrentVersion)
 	c.AgentDeployed = types.BoolValue(false)
+
+	if c.Version.IsUnknown() {
+		c.Version = types.StringPointerValue(cc.CreateCluster.Version)
+	}
+
+	if cc.CreateCluster.Project != nil && cc.CreateCluster.Project.ID != "" {
+		c.ProjectId = types.StringValue(cc.CreateCluster.Project.ID)
+	} else if c.ProjectId.IsUnknown() {
+		c.ProjectId = types.StringNull()
+	}
+}
+
+// nodePoolsFrom keeps node pools null if they were not configured and the API did not return any.
+func nodePoolsFrom(nodePools []*console.NodePoolFragment, config types.Map, ctx context.Context, d *diag.Diagnostics) types.Map {
+	if config.IsUnknown() {
+		config = types.MapNull(types.ObjectType{AttrTypes: common.ClusterNodePoolAttrTypes})
+	}
+
+	if len(nodePools) == 0 && config.IsNull() {
+		return types.MapNull(types.ObjectType{AttrTypes: common.ClusterNodePoolAttrTypes})
+	}
+
+	return common.ClusterNodePoolsFrom(nodePools, config, ctx, d)
+}
+
+// knownStringPointer returns nil for unknown values, so they are not sent to the API as empty strings.
+func knownStringPointer(value types.String) *string {
+	if value.IsUnknown() {
+		return nil
+	}
+
+	return value.ValueStringPointer()
 }
 
 func (c *cluster) ClusterVersionFrom(prov *console.ClusterProviderFragment, version, currentVersion *string) types.String {
@@ -118,6 +204,17 @@ func (c *cluster) ClusterVersionFrom(prov *console.ClusterProviderFragment, vers
 	return types.StringValue("unknown")
 }
 
+// AgentChart returns the agent chart source. The registry password is write-only, so it has to be read from the config.
+func (c *cluster) AgentChart(password types.String) AgentChart {
+	return AgentChart{
+		Path:     c.HelmChartPath.ValueString(),
+		RepoUrl:  c.HelmRepoUrl.ValueString(),
+		Username: c.HelmRepoUsername.ValueString(),
+		Password: password.ValueString(),
+		Version:  c.AgentVersion.ValueString(),
+	}
+}
+
 func (c *cluster) HasKubeconfig() bool {
 	return c.Kubeconfig != nil // || (c.CloudSettings != nil && c.CloudSettings.BYOK != nil && c.CloudSettings.BYOK.Kubeconfig != nil)
 }
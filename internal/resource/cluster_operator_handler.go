@@ -3,6 +3,8 @@ package resource
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -16,7 +18,6 @@ import (
 	gqlclient "github.com/pluralsh/console/go/client"
 	"github.com/pluralsh/plural-cli/pkg/console"
 	"github.com/pluralsh/plural-cli/pkg/helm"
-	"github.com/pluralsh/plural-cli/pkg/utils"
 	"github.com/pluralsh/polly/algorithms"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
@@ -24,48 +25,156 @@ import (
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/chart/loader"
 	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/registry"
 	"helm.sh/helm/v3/pkg/release"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/yaml"
 )
 
-func InstallOrUpgradeAgent(ctx context.Context, client *client.Client, kubeconfig *common.Kubeconfig, kubeClient *common.KubeClient,
-	repoUrl string, values *string, consoleUrl string, token string, clusterId string, d *diag.Diagnostics) error {
+// AgentChart describes where the deployment agent Helm chart is loaded from.
+type AgentChart struct {
+	// Path to a local chart archive. It takes precedence over all other sources.
+	Path string
+
+	// RepoUrl is either a Helm repository URL or an OCI registry URL prefixed with "oci://".
+	RepoUrl string
+
+	// Username and Password are used to authenticate to the OCI registry.
+	Username string
+	Password string
+
+	// Version pins the chart version, it overrides the agent version from the deployment settings.
+	Version string
+}
+
+// vendored checks if the chart vendored by the Console should be used. It is skipped if the chart
+// is pinned to a specific version or if it has to be loaded from a local path or an OCI registry.
+func (ac AgentChart) vendored() bool {
+	return ac.Path == "" && ac.Version == "" && !registry.IsOCI(ac.RepoUrl)
+}
+
+func InstallOrUpgradeAgent(ctx context.Context, client *client.Client, httpClient *http.Client, kubeconfig *common.Kubeconfig, kubeClient *common.KubeClient,
+	agentChart AgentChart, values *string, consoleUrl string, token string, clusterId string, agentWait bool, d *diag.Diagnostics) error {
+	if client.ReadOnly() {
+		return common.ErrReadOnly
+	}
+
 	if lo.IsEmpty(token) {
 		return fmt.Errorf("deploy token cannot be empty")
 	}
 
-	workingDir, chartPath, err := fetchVendoredAgentChart(consoleUrl)
+	var err error
+	if agentChart.vendored() {
+		var workingDir string
+		workingDir, agentChart.Path, err = fetchVendoredAgentChart(ctx, httpClient, consoleUrl)
+		if err != nil {
+			d.AddWarning("Client Warning", fmt.Sprintf("Could not fetch vendored agent chart, using chart from the registry: %s", err))
+		}
+		if workingDir != "" {
+			defer func(path string) {
+				if err := os.RemoveAll(path); err != nil {
+					d.AddError("Provider Error", fmt.Sprintf("Cannot remove temporary working directory, got error: %s", err))
+				}
+			}(workingDir)
+		}
+	}
+
+	// kubeconfig defined on a cluster level can override one defined on the provider level.
+	if kubeconfig != nil {
+		kubeClient, err = common.NewKubeClient(ctx, kubeconfig, lo.ToPtr(console.OperatorNamespace))
+		if err != nil {
+			return err
+		}
+	}
+
+	handler, err := NewOperatorHandler(ctx, client, kubeClient, agentChart, values, consoleUrl, token, clusterId, agentWait)
 	if err != nil {
-		d.AddWarning("Client Warning", fmt.Sprintf("Could not fetch vendored agent chart, using chart from the registry: %s", err))
+		return err
 	}
-	if workingDir != "" {
-		defer func(path string) {
-			if err := os.RemoveAll(path); err != nil {
-				d.AddError("Provider Error", fmt.Sprintf("Cannot remove temporary working directory, got error: %s", err))
-			}
-		}(workingDir)
+
+	since := time.Now()
+	if err = handler.Apply(); err != nil {
+		return handler.withPodStatus(err)
+	}
+
+	if !agentWait {
+		return nil
+	}
+
+	if err = waitForAgentPing(ctx, client, clusterId, since); err != nil {
+		return handler.withPodStatus(fmt.Errorf("agent did not ping the Console: %w", err))
+	}
+
+	return nil
+}
+
+// waitForAgentPing polls the cluster until Console reports an agent ping that happened after the given time.
+func waitForAgentPing(ctx context.Context, client *client.Client, clusterId string, since time.Time) error {
+	return wait.PollUntilContextCancel(ctx, pollInterval, true, func(ctx context.Context) (bool, error) {
+		ping, err := client.GetClusterPing(ctx, clusterId)
+		if err != nil {
+			return false, err
+		}
+
+		return ping.PingedSince(since), nil
+	})
+}
+
+// UninstallAgent removes the agent release, including its deploy token secret, from the cluster. Kubeconfig is
+// resolved the same way as in InstallOrUpgradeAgent. If removeNamespace is set, the operator namespace is deleted as well.
+func UninstallAgent(ctx context.Context, client *client.Client, kubeconfig *common.Kubeconfig, kubeClient *common.KubeClient, removeNamespace bool) error {
+	if client.ReadOnly() {
+		return common.ErrReadOnly
 	}
 
 	// kubeconfig defined on a cluster level can override one defined on the provider level.
 	if kubeconfig != nil {
+		var err error
 		kubeClient, err = common.NewKubeClient(ctx, kubeconfig, lo.ToPtr(console.OperatorNamespace))
 		if err != nil {
 			return err
 		}
 	}
 
-	handler, err := NewOperatorHandler(ctx, client, kubeClient, repoUrl, chartPath, values, consoleUrl, token, clusterId)
+	if kubeClient == nil {
+		return fmt.Errorf("kubeconfig is required to uninstall the agent")
+	}
+
+	configuration := new(action.Configuration)
+	if err := configuration.Init(kubeClient, console.OperatorNamespace, "", logrus.Debugf); err != nil {
+		return err
+	}
+
+	uninstall := action.NewUninstall(configuration)
+	uninstall.Timeout = 5 * time.Minute
+	uninstall.Wait = true
+	uninstall.IgnoreNotFound = true
+	if _, err := uninstall.Run(console.ReleaseName); err != nil {
+		return err
+	}
+
+	if !removeNamespace {
+		return nil
+	}
+
+	clientSet, err := kubeClient.ToClientSet()
 	if err != nil {
 		return err
 	}
 
-	return handler.Apply()
+	err = clientSet.CoreV1().Namespaces().Delete(ctx, console.OperatorNamespace, metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
 }
 
-func fetchVendoredAgentChart(consoleURL string) (string, string, error) {
+func fetchVendoredAgentChart(ctx context.Context, httpClient *http.Client, consoleURL string) (string, string, error) {
 	parsedConsoleURL, err := url.Parse(consoleURL)
 	if err != nil {
 		return "", "", fmt.Errorf("cannot parse console URL: %s", err.Error())
@@ -76,17 +185,44 @@ func fetchVendoredAgentChart(consoleURL string) (string, string, error) {
 		return directory, "", fmt.Errorf("cannot create directory: %s", err.Error())
 	}
 
-	agentChartURL := fmt.Sprintf("https://%s/ext/v1/agent/chart", parsedConsoleURL.Host)
+	scheme := lo.Ternary(parsedConsoleURL.Scheme == "", "https", parsedConsoleURL.Scheme)
+	agentChartURL := fmt.Sprintf("%s://%s/ext/v1/agent/chart", scheme, parsedConsoleURL.Host)
 	agentChartPath := filepath.Join(directory, "agent-chart.tgz")
-	if err = utils.DownloadFile(agentChartPath, agentChartURL); err != nil {
+	if err = downloadFile(ctx, httpClient, agentChartPath, agentChartURL); err != nil {
 		return directory, "", fmt.Errorf("cannot download agent chart: %s", err.Error())
 	}
 
 	return directory, agentChartPath, nil
 }
 
+func downloadFile(ctx context.Context, httpClient *http.Client, path, source string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := lo.Ternary(httpClient != nil, httpClient, http.DefaultClient).Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, resp.Body)
+	return err
+}
+
 func NewOperatorHandler(ctx context.Context, client *client.Client, kubeClient *common.KubeClient,
-	repoUrl, chartPath string, values *string, consoleUrl, token string, clusterId string) (*OperatorHandler, error) {
+	agentChart AgentChart, values *string, consoleUrl, token string, clusterId string, agentWait bool) (*OperatorHandler, error) {
 	settings, err := client.GetDeploymentSettings(ctx)
 	if err != nil {
 		return nil, err
@@ -110,18 +246,19 @@ func NewOperatorHandler(ctx context.Context, client *client.Client, kubeClient *
 	}
 
 	handler := &OperatorHandler{
-		ctx:               ctx,
-		consoleURL:        consoleUrl,
-		deployToken:       token,
-		settings:          deploymentSettings,
-		cluster:           cluster,
-		clientSet:         clientSet,
-		vendoredChartPath: chartPath,
-		additionalValues:  additionalValues,
-		clusterId:         clusterId,
-	}
-
-	if err := handler.init(kubeClient, repoUrl); err != nil {
+		ctx:              ctx,
+		consoleURL:       consoleUrl,
+		deployToken:      token,
+		settings:         deploymentSettings,
+		cluster:          cluster,
+		clientSet:        clientSet,
+		agentChart:       agentChart,
+		additionalValues: additionalValues,
+		clusterId:        clusterId,
+		wait:             agentWait,
+	}
+
+	if err := handler.init(kubeClient); err != nil {
 		return nil, err
 	}
 
@@ -137,15 +274,18 @@ type OperatorHandler struct {
 	clientSet   *kubernetes.Clientset
 	clusterId   string
 
-	// vendoredChartPath contains a local path to vendored agent chart if it was downloadable, it is empty otherwise.
-	vendoredChartPath string
+	// wait enables waiting for the agent workloads to become ready during Helm install and upgrade.
+	wait bool
+
+	// agentChart describes the chart source. Its path contains a local path to vendored agent chart if it was downloadable.
+	agentChart AgentChart
 
 	chart            *chart.Chart
 	configuration    *action.Configuration
 	additionalValues map[string]any
 }
 
-func (oh *OperatorHandler) init(kubeconfig *common.KubeClient, repoUrl string) error {
+func (oh *OperatorHandler) init(kubeconfig *common.KubeClient) error {
 	if oh.configuration != nil {
 		return fmt.Errorf("operator handler is already initialized")
 	}
@@ -157,17 +297,29 @@ func (oh *OperatorHandler) init(kubeconfig *common.KubeClient, repoUrl string) e
 
 	var path string
 	var err error
-	if oh.vendoredChartPath != "" {
-		path = oh.vendoredChartPath
-	} else {
-		if err := helm.AddRepo(console.ReleaseName, repoUrl); err != nil {
+	switch {
+	case oh.agentChart.Path != "":
+		path = oh.agentChart.Path
+	case registry.IsOCI(oh.agentChart.RepoUrl):
+		oh.configuration.RegistryClient, err = registry.NewClient(registry.ClientOptBasicAuth(oh.agentChart.Username, oh.agentChart.Password))
+		if err != nil {
 			return err
 		}
 
 		install := action.NewInstall(oh.configuration)
-		if oh.settings != nil {
-			install.Version = strings.TrimPrefix(oh.settings.AgentVsn, "v")
+		install.Version = oh.chartVersion()
+
+		chartName := fmt.Sprintf("%s/%s", strings.TrimSuffix(oh.agentChart.RepoUrl, "/"), console.ChartName)
+		if path, err = install.LocateChart(chartName, cli.New()); err != nil {
+			return err
 		}
+	default:
+		if err := helm.AddRepo(console.ReleaseName, oh.agentChart.RepoUrl); err != nil {
+			return err
+		}
+
+		install := action.NewInstall(oh.configuration)
+		install.Version = oh.chartVersion()
 
 		chartName := fmt.Sprintf("%s/%s", console.ReleaseName, console.ChartName)
 		if path, err = install.LocateChart(chartName, cli.New()); err != nil {
@@ -179,6 +331,20 @@ func (oh *OperatorHandler) init(kubeconfig *common.KubeClient, repoUrl string) e
 	return err
 }
 
+// chartVersion returns the agent chart version to install. Explicitly pinned version takes precedence over
+// the agent version from the deployment settings.
+func (oh *OperatorHandler) chartVersion() string {
+	if oh.agentChart.Version != "" {
+		return strings.TrimPrefix(oh.agentChart.Version, "v")
+	}
+
+	if oh.settings != nil {
+		return strings.TrimPrefix(oh.settings.AgentVsn, "v")
+	}
+
+	return ""
+}
+
 func (oh *OperatorHandler) Apply() error {
 	if err := oh.ensureNamespace(); err != nil {
 		return err
@@ -238,7 +404,7 @@ func (oh *OperatorHandler) upgrade() error {
 	upgrade := action.NewUpgrade(oh.configuration)
 	upgrade.Namespace = console.OperatorNamespace
 	upgrade.Timeout = 5 * time.Minute
-	upgrade.Wait = false
+	upgrade.Wait = oh.wait
 
 	values, err := oh.values()
 	if err != nil {
@@ -254,7 +420,7 @@ func (oh *OperatorHandler) install() error {
 	install.Namespace = console.OperatorNamespace
 	install.ReleaseName = console.ReleaseName
 	install.Timeout = 5 * time.Minute
-	install.Wait = false
+	install.Wait = oh.wait
 	install.CreateNamespace = true
 
 	values, err := oh.values()
@@ -266,6 +432,54 @@ func (oh *OperatorHandler) install() error {
 	return err
 }
 
+// withPodStatus appends status of the agent pods to the error if waiting is enabled, it helps to find out
+// why the agent did not become ready.
+func (oh *OperatorHandler) withPodStatus(err error) error {
+	if !oh.wait {
+		return err
+	}
+
+	pods, listErr := oh.clientSet.CoreV1().Pods(console.OperatorNamespace).List(oh.ctx, metav1.ListOptions{})
+	if listErr != nil {
+		return fmt.Errorf("%w, unable to list agent pods: %s", err, listErr)
+	}
+
+	if len(pods.Items) == 0 {
+		return fmt.Errorf("%w, no agent pods found in %s namespace", err, console.OperatorNamespace)
+	}
+
+	return fmt.Errorf("%w, agent pods:\n%s", err, strings.Join(algorithms.Map(pods.Items, podStatus), "\n"))
+}
+
+// podStatus describes pod phase together with the reasons why its containers are not ready.
+func podStatus(pod v1.Pod) string {
+	result := fmt.Sprintf("- %s: %s", pod.Name, pod.Status.Phase)
+	if pod.Status.Reason != "" || pod.Status.Message != "" {
+		result += fmt.Sprintf(" (%s %s)", pod.Status.Reason, pod.Status.Message)
+	}
+
+	for _, container := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		if container.Ready {
+			continue
+		}
+
+		switch {
+		case container.State.Waiting != nil:
+			result += fmt.Sprintf(", container %s is waiting: %s %s", container.Name, container.State.Waiting.Reason, container.State.Waiting.Message)
+		case container.State.Terminated != nil:
+			result += fmt.Sprintf(", container %s is terminated: %s %s", container.Name, container.State.Terminated.Reason, container.State.Terminated.Message)
+		default:
+			result += fmt.Sprintf(", container %s is not ready", container.Name)
+		}
+
+		if container.RestartCount > 0 {
+			result += fmt.Sprintf(" (restarts: %d)", container.RestartCount)
+		}
+	}
+
+	return result
+}
+
 func (oh *OperatorHandler) values() (map[string]any, error) {
 	settingsValues, err := resolveAgentHelmValues(oh.settings, oh.cluster)
 	if err != nil {
@@ -287,13 +501,13 @@ func fetchClusterForAgentHelmValues(ctx context.Context, client *client.Client,
 		return nil, fmt.Errorf("cluster id is required to render agent helm values")
 	}
 
-	resp, err := client.GetCluster(ctx, lo.ToPtr(clusterID))
+	cluster, err := client.GetClusterForAgentValues(ctx, clusterID)
 	if err != nil {
 		return nil, fmt.Errorf("fetching cluster for agent helm values templating: %w", err)
 	}
-	if resp == nil || resp.Cluster == nil {
+	if cluster == nil {
 		return nil, fmt.Errorf("fetching cluster for agent helm values templating: cluster not found")
 	}
 
-	return resp.Cluster, nil
+	return cluster, nil
 }
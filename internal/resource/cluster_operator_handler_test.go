@@ -7,6 +7,8 @@ import (
 
 	gqlclient "github.com/pluralsh/console/go/client"
 	"github.com/samber/lo"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/yaml"
 )
 
@@ -133,6 +135,62 @@ clusterName: '{{ cluster.name }}'
 	}
 }
 
+func TestPodStatusDescribesNotReadyContainers(t *testing.T) {
+	pod := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "deploy-operator-0"},
+		Status: v1.PodStatus{
+			Phase: v1.PodPending,
+			ContainerStatuses: []v1.ContainerStatus{
+				{Name: "ready", Ready: true},
+				{
+					Name:         "agent",
+					RestartCount: 3,
+					State: v1.ContainerState{
+						Waiting: &v1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "image not found"},
+					},
+				},
+			},
+		},
+	}
+
+	expected := "- deploy-operator-0: Pending, container agent is waiting: ImagePullBackOff image not found (restarts: 3)"
+	if got := podStatus(pod); got != expected {
+		t.Fatalf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestAgentChartVendored(t *testing.T) {
+	cases := []struct {
+		chart    AgentChart
+		vendored bool
+	}{
+		{chart: AgentChart{RepoUrl: "https://pluralsh.github.io/deployment-operator"}, vendored: true},
+		{chart: AgentChart{RepoUrl: "oci://registry.example.com/charts"}, vendored: false},
+		{chart: AgentChart{RepoUrl: "https://pluralsh.github.io/deployment-operator", Path: "/charts/agent.tgz"}, vendored: false},
+		{chart: AgentChart{RepoUrl: "https://pluralsh.github.io/deployment-operator", Version: "0.5.0"}, vendored: false},
+	}
+
+	for _, c := range cases {
+		if result := c.chart.vendored(); result != c.vendored {
+			t.Errorf("expected %v for chart %+v, got %v", c.vendored, c.chart, result)
+		}
+	}
+}
+
+func TestOperatorHandlerChartVersionPrefersPinnedVersion(t *testing.T) {
+	settings := &gqlclient.DeploymentSettingsFragment{AgentVsn: "v0.4.0"}
+
+	handler := &OperatorHandler{settings: settings}
+	if version := handler.chartVersion(); version != "0.4.0" {
+		t.Fatalf("expected version from deployment settings, got %q", version)
+	}
+
+	handler.agentChart.Version = "v0.5.1"
+	if version := handler.chartVersion(); version != "0.5.1" {
+		t.Fatalf("expected pinned version, got %q", version)
+	}
+}
+
 func nestedMap(t *testing.T, values map[string]any, key string) map[string]any {
 	t.Helper()
 
@@ -1,21 +1,28 @@
 package resource
 
 import (
+	"context"
+
 	"terraform-provider-plural/internal/common"
 	resource "terraform-provider-plural/internal/planmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/pluralsh/plural-cli/pkg/console"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func (r *clusterResource) schema() schema.Schema {
+func (r *clusterResource) schema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Description:         "Cluster represents a Kubernetes cluster managed by the Plural Console for continuous deployment. Clusters serve as deployment targets for services and can be either management clusters (hosting the Plural Console and operators) or workload clusters (running application workloads). The Console tracks cluster health, versions, and coordinates service deployments across the fleet.",
 		MarkdownDescription: "Cluster represents a Kubernetes cluster managed by the Plural Console for continuous deployment. Clusters serve as deployment targets for services and can be either management clusters (hosting the Plural Console and operators) or workload clusters (running application workloads). The Console tracks cluster health, versions, and coordinates service deployments across the fleet.",
@@ -47,9 +54,11 @@ func (r *clusterResource) schema() schema.Schema {
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"project_id": schema.StringAttribute{
-				Description:         "ID of the project that this cluster belongs to.",
-				MarkdownDescription: "ID of the project that this cluster belongs to.",
+				Description:         "ID of the project that this cluster belongs to. Defaults to the provider default_project_id.",
+				MarkdownDescription: "ID of the project that this cluster belongs to. Defaults to the provider `default_project_id`.",
 				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"detach": schema.BoolAttribute{
 				Description:         "Determines behavior during resource destruction, if true it will detach resource instead of deleting it.",
@@ -58,6 +67,28 @@ func (r *clusterResource) schema() schema.Schema {
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"detach_on_delete_timeout": schema.BoolAttribute{
+				Description:         "If true, the cluster is detached with a warning when it is not deleted before the delete timeout. If false, destroy fails instead and can be retried. Defaults to true.",
+				MarkdownDescription: "If `true`, the cluster is detached with a warning when it is not deleted before the delete timeout. If `false`, destroy fails instead and can be retried. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"uninstall_agent_on_destroy": schema.BoolAttribute{
+				Description:         "If true, the deployment agent Helm release and its deploy token secret are uninstalled from the cluster on destroy. It uses the same kubeconfig as the agent installation.",
+				MarkdownDescription: "If `true`, the deployment agent Helm release and its deploy token secret are uninstalled from the cluster on destroy. It uses the same kubeconfig as the agent installation.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"remove_agent_namespace": schema.BoolAttribute{
+				Description:         "If true, the operator namespace is deleted after the deployment agent is uninstalled. Requires uninstall_agent_on_destroy.",
+				MarkdownDescription: "If `true`, the operator namespace is deleted after the deployment agent is uninstalled. Requires `uninstall_agent_on_destroy`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Validators:          []validator.Bool{boolvalidator.AlsoRequires(path.MatchRoot("uninstall_agent_on_destroy"))},
+			},
 			"metadata": schema.StringAttribute{
 				Description:         "Arbitrary JSON metadata to store user-specific state of this cluster (e.g. IAM roles for add-ons). Use 'jsonencode' and 'jsondecode' methods to encode and decode data.",
 				MarkdownDescription: "Arbitrary JSON metadata to store user-specific state of this cluster (e.g. IAM roles for add-ons). Use `jsonencode` and `jsondecode` methods to encode and decode data.",
@@ -66,18 +97,152 @@ func (r *clusterResource) schema() schema.Schema {
 				Default:             stringdefault.StaticString("{}"),
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
+			"version": schema.StringAttribute{
+				Description:         "Desired Kubernetes version for this cluster. Changing it upgrades the cluster, the plan fails if Console upgrade preflight checks report any blockers.",
+				MarkdownDescription: "Desired Kubernetes version for this cluster. Changing it upgrades the cluster, the plan fails if Console upgrade preflight checks report any blockers.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"current_version": schema.StringAttribute{
+				Description:         "Kubernetes version currently running on this cluster.",
+				MarkdownDescription: "Kubernetes version currently running on this cluster.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"wait_for_upgrade": schema.BoolAttribute{
+				Description:         "If set to true, updates wait until the cluster runs the desired version or the update timeout is reached.",
+				MarkdownDescription: "If set to `true`, updates wait until the cluster runs the desired `version` or the update timeout is reached.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"node_pools": schema.MapNestedAttribute{
+				Description:         "Map of node pool specs managed by this cluster, where the key is name of the node pool and value contains the spec. Removing this attribute leaves node pools unchanged, set it to an empty map to remove all of them.",
+				MarkdownDescription: "Map of node pool specs managed by this cluster, where the key is name of the node pool and value contains the spec. Removing this attribute leaves node pools unchanged, set it to an empty map to remove all of them.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Map{mapplanmodifier.UseStateForUnknown()},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description:         "Node pool name. It has to match the key of this node pool.",
+							MarkdownDescription: "Node pool name. It has to match the key of this node pool.",
+							Required:            true,
+						},
+						"min_size": schema.Int64Attribute{
+							Description:         "Minimum number of instances in this node pool.",
+							MarkdownDescription: "Minimum number of instances in this node pool.",
+							Required:            true,
+						},
+						"max_size": schema.Int64Attribute{
+							Description:         "Maximum number of instances in this node pool.",
+							MarkdownDescription: "Maximum number of instances in this node pool.",
+							Required:            true,
+						},
+						"instance_type": schema.StringAttribute{
+							Description:         "The type of used node. Usually cloud-specific.",
+							MarkdownDescription: "The type of used node. Usually cloud-specific.",
+							Required:            true,
+						},
+						"labels": schema.MapAttribute{
+							Description:         "Kubernetes labels applied to the nodes in this pool.",
+							MarkdownDescription: "Kubernetes labels applied to the nodes in this pool.",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+						"taints": schema.SetNestedAttribute{
+							Description:         "Taints applied to a node.",
+							MarkdownDescription: "Taints applied to a node.",
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										Required: true,
+									},
+									"value": schema.StringAttribute{
+										Required: true,
+									},
+									"effect": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.OneOf("NoSchedule", "PreferNoSchedule", "NoExecute"),
+										},
+									},
+								},
+							},
+						},
+						"cloud_settings": schema.SingleNestedAttribute{
+							Description:         "Cloud-specific settings for this node pool.",
+							MarkdownDescription: "Cloud-specific settings for this node pool.",
+							Optional:            true,
+							Attributes: map[string]schema.Attribute{
+								"aws": schema.SingleNestedAttribute{
+									Description:         "AWS node pool customizations.",
+									MarkdownDescription: "AWS node pool customizations.",
+									Optional:            true,
+									Attributes: map[string]schema.Attribute{
+										"launch_template_id": schema.StringAttribute{
+											Description:         "Custom launch template for your nodes. Useful for Golden AMI setups.",
+											MarkdownDescription: "Custom launch template for your nodes. Useful for Golden AMI setups.",
+											Optional:            true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"helm_repo_url": schema.StringAttribute{
-				Description:         "Helm repository URL you'd like to use in deployment agent Helm install.",
-				MarkdownDescription: "Helm repository URL you'd like to use in deployment agent Helm install.",
+				Description:         "Helm repository URL you'd like to use in deployment agent Helm install. OCI registries are supported with oci:// prefix, i.e. oci://registry.example.com/charts.",
+				MarkdownDescription: "Helm repository URL you'd like to use in deployment agent Helm install. OCI registries are supported with `oci://` prefix, i.e. `oci://registry.example.com/charts`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString(console.RepoUrl),
 			},
+			"helm_repo_username": schema.StringAttribute{
+				Description:         "Username used to authenticate to the OCI registry set in helm_repo_url.",
+				MarkdownDescription: "Username used to authenticate to the OCI registry set in `helm_repo_url`.",
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("helm_repo_password"))},
+			},
+			"helm_repo_password": schema.StringAttribute{
+				Description:         "Password used to authenticate to the OCI registry set in helm_repo_url. It is never stored in the state. Requires Terraform 1.11 or later.",
+				MarkdownDescription: "Password used to authenticate to the OCI registry set in `helm_repo_url`. It is never stored in the state. Requires Terraform 1.11 or later.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators:          []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("helm_repo_username"))},
+			},
+			"helm_repo_password_hash": schema.StringAttribute{
+				Description:         "SHA-256 hash of helm_repo_password. Used to detect changes of the password.",
+				MarkdownDescription: "SHA-256 hash of `helm_repo_password`. Used to detect changes of the password.",
+				Computed:            true,
+			},
+			"helm_chart_path": schema.StringAttribute{
+				Description:         "Path to a local deployment agent chart archive. If set, the chart is not downloaded, which is useful for air-gapped environments.",
+				MarkdownDescription: "Path to a local deployment agent chart archive. If set, the chart is not downloaded, which is useful for air-gapped environments.",
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("agent_version"))},
+			},
+			"agent_version": schema.StringAttribute{
+				Description:         "Deployment agent chart version to install. It overrides the agent version from the Console deployment settings.",
+				MarkdownDescription: "Deployment agent chart version to install. It overrides the agent version from the Console deployment settings.",
+				Optional:            true,
+			},
 			"helm_values": schema.StringAttribute{
 				Description:         "Additional Helm values you'd like to use in deployment agent Helm installs. This is useful for BYOK clusters that need to use custom images or other constructs.",
 				MarkdownDescription: "Additional Helm values you'd like to use in deployment agent Helm installs. This is useful for BYOK clusters that need to use custom images or other constructs.",
 				Optional:            true,
 			},
+			"agent_wait": schema.BoolAttribute{
+				Description:         "If set to true, deployment agent Helm installs wait until agent workloads are ready and the agent pings the Console. Waiting is limited by the create and update timeouts.",
+				MarkdownDescription: "If set to `true`, deployment agent Helm installs wait until agent workloads are ready and the agent pings the Console. Waiting is limited by the create and update timeouts.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"kubeconfig": common.KubeconfigResourceSchema(),
 			"protect": schema.BoolAttribute{
 				Description:         "If set to \"true\" then this cluster cannot be deleted.",
@@ -92,6 +257,12 @@ func (r *clusterResource) schema() schema.Schema {
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"tags_all": schema.MapAttribute{
+				Description:         "Key-value tags of this cluster, including tags inherited from the provider default_tags.",
+				MarkdownDescription: "Key-value tags of this cluster, including tags inherited from the provider `default_tags`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"bindings": schema.SingleNestedAttribute{
 				Description:         "Read and write policies of this cluster.",
 				MarkdownDescription: "Read and write policies of this cluster.",
@@ -143,5 +314,8 @@ func (r *clusterResource) schema() schema.Schema {
 				PlanModifiers:       []planmodifier.Bool{resource.EnsureAgent()},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
@@ -51,6 +51,10 @@ func (r *CustomStackRunResource) Configure(_ context.Context, req resource.Confi
 }
 
 func (r *CustomStackRunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if common.CheckReadOnly(r.client, "create", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.CustomStackRun)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -64,7 +68,7 @@ func (r *CustomStackRunResource) Create(ctx context.Context, req resource.Create
 	}
 	sd, err := r.client.CreateCustomStackRun(ctx, *attr)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom stack run, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create custom stack run", err)
 		return
 	}
 
@@ -90,6 +94,10 @@ func (r *CustomStackRunResource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *CustomStackRunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if common.CheckReadOnly(r.client, "update", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.CustomStackRun)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -103,7 +111,7 @@ func (r *CustomStackRunResource) Update(ctx context.Context, req resource.Update
 	}
 	_, err = r.client.UpdateCustomStackRun(ctx, data.Id.ValueString(), *attr)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom stack run, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update custom stack run", err)
 		return
 	}
 
@@ -111,6 +119,10 @@ func (r *CustomStackRunResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *CustomStackRunResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if common.CheckReadOnly(r.client, "delete", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.CustomStackRun)
 	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -0,0 +1,42 @@
+package resource
+
+import (
+	"context"
+
+	"terraform-provider-plural/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// modifyPlanDefaultProject sets project_id to the provider default project if it is not configured.
+// It is only applied on create, since existing resources cannot be moved between projects.
+func modifyPlanDefaultProject(ctx context.Context, defaults *common.Defaults, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || defaults == nil || defaults.ProjectId == "" {
+		return
+	}
+
+	var projectId types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("project_id"), &projectId)...)
+	if resp.Diagnostics.HasError() || !projectId.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("project_id"), types.StringValue(defaults.ProjectId))...)
+}
+
+// modifyPlanDefaultTags sets tags_all to the provider default tags merged with tags.
+func modifyPlanDefaultTags(ctx context.Context, defaults *common.Defaults, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var tags types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), defaults.TagsAll(ctx, tags, &resp.Diagnostics))...)
+}
@@ -0,0 +1,28 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"terraform-provider-plural/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// modifyPlanRequireFeature fails the plan if the Console does not support the feature required by the resource.
+// It is checked on create and update, destroy is always allowed. If supported features cannot be detected,
+// a warning is reported and the plan continues.
+func modifyPlanRequireFeature(ctx context.Context, c *client.Client, feature client.Feature, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || c == nil {
+		return
+	}
+
+	err := c.RequireFeature(ctx, feature)
+	switch {
+	case errors.Is(err, client.ErrUnknownCapabilities):
+		resp.Diagnostics.AddWarning("Unknown Console Features", fmt.Sprintf("%s are assumed to be supported, got error: %s", feature.Name, err))
+	case err != nil:
+		resp.Diagnostics.AddError("Unsupported Feature", err.Error())
+	}
+}
@@ -145,6 +145,10 @@ func (r *GitRepositoryResource) Configure(
 }
 
 func (r *GitRepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if common.CheckReadOnly(r.client, "create", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.GitRepositoryExtended)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -153,7 +157,7 @@ func (r *GitRepositoryResource) Create(ctx context.Context, req resource.CreateR
 
 	response, err := r.client.CreateGitRepository(ctx, data.Attributes())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create GitRepository, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create GitRepository", err)
 		return
 	}
 
@@ -184,6 +188,10 @@ func (r *GitRepositoryResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *GitRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if common.CheckReadOnly(r.client, "update", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.GitRepositoryExtended)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -192,7 +200,7 @@ func (r *GitRepositoryResource) Update(ctx context.Context, req resource.UpdateR
 
 	_, err := r.client.UpdateGitRepository(ctx, data.Id.ValueString(), data.Attributes())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update GitRepository, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update GitRepository", err)
 		return
 	}
 
@@ -200,6 +208,10 @@ func (r *GitRepositoryResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *GitRepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if common.CheckReadOnly(r.client, "delete", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.GitRepositoryExtended)
 	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
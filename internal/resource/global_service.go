@@ -103,6 +103,10 @@ func (r *GlobalServiceResource) Configure(
 }
 
 func (r *GlobalServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if common.CheckReadOnly(r.client, "create", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.GlobalService)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -111,7 +115,7 @@ func (r *GlobalServiceResource) Create(ctx context.Context, req resource.CreateR
 
 	response, err := r.client.CreateGlobalServiceDeployment(ctx, data.ServiceId.ValueString(), data.Attributes(ctx, &resp.Diagnostics))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create GlobalService, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create GlobalService", err)
 		return
 	}
 
@@ -130,6 +134,10 @@ func (r *GlobalServiceResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *GlobalServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if common.CheckReadOnly(r.client, "update", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.GlobalService)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -138,7 +146,7 @@ func (r *GlobalServiceResource) Update(ctx context.Context, req resource.UpdateR
 
 	_, err := r.client.UpdateGlobalService(ctx, data.Id.ValueString(), data.Attributes(ctx, &resp.Diagnostics))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update GlobalService, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update GlobalService", err)
 		return
 	}
 
@@ -146,6 +154,10 @@ func (r *GlobalServiceResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *GlobalServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if common.CheckReadOnly(r.client, "delete", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.GlobalService)
 	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
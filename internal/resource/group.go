@@ -87,6 +87,10 @@ func (r *GroupResource) Configure(
 }
 
 func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if common.CheckReadOnly(r.client, "create", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.Group)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -95,7 +99,7 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	response, err := r.client.CreateGroup(ctx, data.Attributes())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create group, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create group", err)
 		return
 	}
 
@@ -126,6 +130,10 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if common.CheckReadOnly(r.client, "update", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.Group)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -134,7 +142,7 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	_, err := r.client.UpdateGroup(ctx, data.Id.ValueString(), data.Attributes())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update group, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update group", err)
 		return
 	}
 
@@ -142,6 +150,10 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if common.CheckReadOnly(r.client, "delete", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.Group)
 	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -3,6 +3,7 @@ package resource
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"terraform-provider-plural/internal/common"
 	"terraform-provider-plural/internal/model"
@@ -84,6 +85,10 @@ func (r *GroupMemberResource) Configure(
 }
 
 func (r *GroupMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if common.CheckReadOnly(r.client, "create", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.GroupMember)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -100,15 +105,41 @@ func (r *GroupMemberResource) Create(ctx context.Context, req resource.CreateReq
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
-func (r *GroupMemberResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
-	// Ignore.
+func (r *GroupMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := new(model.GroupMember)
+	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.GetGroupMember(ctx, data.GroupId.ValueString(), data.UserId.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group member, got error: %s", err))
+		return
+	}
+	if response == nil || client.IsNotFound(err) {
+		// Resource not found, remove from state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.From(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
-func (r *GroupMemberResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+func (r *GroupMemberResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if common.CheckReadOnly(r.client, "update", &resp.Diagnostics) {
+		return
+	}
+
 	// Resource schema requires replacement on changes. Ignore.
 }
 
 func (r *GroupMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if common.CheckReadOnly(r.client, "delete", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.GroupMember)
 	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -122,5 +153,15 @@ func (r *GroupMemberResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *GroupMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	ids := strings.Split(req.ID, ",")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: group_id,user_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), ids[1])...)
 }
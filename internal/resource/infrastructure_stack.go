@@ -3,7 +3,6 @@ package resource
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"terraform-provider-plural/internal/client"
 	"terraform-provider-plural/internal/common"
@@ -16,6 +15,7 @@ import (
 
 var _ resource.Resource = &InfrastructureStackResource{}
 var _ resource.ResourceWithImportState = &InfrastructureStackResource{}
+var _ resource.ResourceWithModifyPlan = &InfrastructureStackResource{}
 
 func NewInfrastructureStackResource() resource.Resource {
 	return &InfrastructureStackResource{}
@@ -23,15 +23,16 @@ func NewInfrastructureStackResource() resource.Resource {
 
 // InfrastructureStackResource defines the infrastructure stack resource implementation.
 type InfrastructureStackResource struct {
-	client *client.Client
+	client   *client.Client
+	defaults *common.Defaults
 }
 
 func (r *InfrastructureStackResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_infrastructure_stack"
 }
 
-func (r *InfrastructureStackResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = r.schema()
+func (r *InfrastructureStackResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = r.schema(ctx)
 }
 
 func (r *InfrastructureStackResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,15 +51,33 @@ func (r *InfrastructureStackResource) Configure(_ context.Context, req resource.
 	}
 
 	r.client = data.Client
+	r.defaults = data.Defaults
+}
+
+func (r *InfrastructureStackResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultProject(ctx, r.defaults, req, resp)
 }
 
 func (r *InfrastructureStackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if common.CheckReadOnly(r.client, "create", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.InfrastructureStackExtended)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	attr, err := data.Attributes(ctx, &resp.Diagnostics, r.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get attributes, got error: %s", err))
@@ -66,7 +85,7 @@ func (r *InfrastructureStackResource) Create(ctx context.Context, req resource.C
 	}
 	sd, err := r.client.CreateStack(ctx, *attr)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create infrastructure stack, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create infrastructure stack", err)
 		return
 	}
 
@@ -97,12 +116,25 @@ func (r *InfrastructureStackResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *InfrastructureStackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if common.CheckReadOnly(r.client, "update", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.InfrastructureStackExtended)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	attr, err := data.Attributes(ctx, &resp.Diagnostics, r.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get attributes, got error: %s", err))
@@ -110,7 +142,7 @@ func (r *InfrastructureStackResource) Update(ctx context.Context, req resource.U
 	}
 	_, err = r.client.UpdateStack(ctx, data.Id.ValueString(), *attr)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update infrastructure stack, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update infrastructure stack", err)
 		return
 	}
 
@@ -118,12 +150,22 @@ func (r *InfrastructureStackResource) Update(ctx context.Context, req resource.U
 }
 
 func (r *InfrastructureStackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if common.CheckReadOnly(r.client, "delete", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.InfrastructureStackExtended)
 	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if data.Detach.ValueBool() {
 		_, err := r.client.DetachStack(ctx, data.Id.ValueString())
 		if err != nil && !client.IsNotFound(err) {
@@ -136,7 +178,7 @@ func (r *InfrastructureStackResource) Delete(ctx context.Context, req resource.D
 			return
 		}
 
-		if err := wait.PollUntilContextTimeout(ctx, 10*time.Second, 10*time.Minute, true, func(ctx context.Context) (bool, error) {
+		if err := wait.PollUntilContextTimeout(ctx, pollInterval, deleteTimeout, true, func(ctx context.Context) (bool, error) {
 			response, err := r.client.GetInfrastructureStack(ctx, data.Id.ValueStringPointer(), nil)
 			if client.IsNotFound(err) {
 				return true, nil
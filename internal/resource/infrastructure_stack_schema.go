@@ -1,6 +1,7 @@
 package resource
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
@@ -17,7 +18,7 @@ import (
 	gqlclient "github.com/pluralsh/console/go/client"
 )
 
-func (r *InfrastructureStackResource) schema() schema.Schema {
+func (r *InfrastructureStackResource) schema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: "Infrastructure stack provides a scalable framework to manage infrastructure as code with a K8s-friendly, API-driven approach. It declaratively defines a stack with a type, Git repository location, and target cluster for execution. On each commit to the tracked repository, a run is created which the Plural deployment operator detects and executes on the targeted cluster, enabling fine-grained permissions and network location control for IaC runs.",
 		Attributes: map[string]schema.Attribute{
@@ -58,8 +59,8 @@ func (r *InfrastructureStackResource) schema() schema.Schema {
 				Optional:            true,
 			},
 			"project_id": schema.StringAttribute{
-				Description:         "ID of the project that this stack belongs to.",
-				MarkdownDescription: "ID of the project that this stack belongs to.",
+				Description:         "ID of the project that this stack belongs to. Defaults to the provider default_project_id.",
+				MarkdownDescription: "ID of the project that this stack belongs to. Defaults to the provider `default_project_id`.",
 				Computed:            true,
 				Optional:            true,
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
@@ -392,5 +393,8 @@ func (r *InfrastructureStackResource) schema() schema.Schema {
 				PlanModifiers: []planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
@@ -22,6 +22,7 @@ import (
 
 var _ resource.Resource = &ObservabilityWebhookResource{}
 var _ resource.ResourceWithImportState = &ObservabilityWebhookResource{}
+var _ resource.ResourceWithModifyPlan = &ObservabilityWebhookResource{}
 
 func NewObservabilityWebhookResource() resource.Resource {
 	return &ObservabilityWebhookResource{}
@@ -97,7 +98,15 @@ func (r *ObservabilityWebhookResource) Configure(_ context.Context, req resource
 	r.client = data.Client
 }
 
+func (r *ObservabilityWebhookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanRequireFeature(ctx, r.client, client.FeatureObservabilityWebhook, req, resp)
+}
+
 func (r *ObservabilityWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if common.CheckReadOnly(r.client, "create", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.ObservabilityWebhook)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -106,7 +115,7 @@ func (r *ObservabilityWebhookResource) Create(ctx context.Context, req resource.
 
 	sc, err := r.client.UpsertObservabilityWebhook(ctx, data.Attributes())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create observability webhook, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create observability webhook", err)
 		return
 	}
 
@@ -137,6 +146,10 @@ func (r *ObservabilityWebhookResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *ObservabilityWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if common.CheckReadOnly(r.client, "update", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.ObservabilityWebhook)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -145,7 +158,7 @@ func (r *ObservabilityWebhookResource) Update(ctx context.Context, req resource.
 
 	_, err := r.client.UpsertObservabilityWebhook(ctx, data.Attributes())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update observability webhook, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update observability webhook", err)
 		return
 	}
 
@@ -153,6 +166,10 @@ func (r *ObservabilityWebhookResource) Update(ctx context.Context, req resource.
 }
 
 func (r *ObservabilityWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if common.CheckReadOnly(r.client, "delete", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.ObservabilityWebhook)
 	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
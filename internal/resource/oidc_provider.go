@@ -159,6 +159,10 @@ func (r *OIDCProviderResource) Configure(_ context.Context, req resource.Configu
 }
 
 func (r *OIDCProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if common.CheckReadOnly(r.client, "create", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.OIDCProvider)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -167,7 +171,7 @@ func (r *OIDCProviderResource) Create(ctx context.Context, req resource.CreateRe
 
 	result, err := r.client.CreateOIDCProvider(ctx, data.TypeAttribute(), data.Attributes(ctx, &resp.Diagnostics))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create OIDC provider, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create OIDC provider", err)
 		return
 	}
 
@@ -180,6 +184,10 @@ func (r *OIDCProviderResource) Read(_ context.Context, _ resource.ReadRequest, _
 }
 
 func (r *OIDCProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if common.CheckReadOnly(r.client, "update", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.OIDCProvider)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -188,7 +196,7 @@ func (r *OIDCProviderResource) Update(ctx context.Context, req resource.UpdateRe
 
 	result, err := r.client.UpdateOIDCProvider(ctx, data.ID.ValueString(), data.TypeAttribute(), data.Attributes(ctx, &resp.Diagnostics))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update OIDC provider, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update OIDC provider", err)
 		return
 	}
 
@@ -197,6 +205,10 @@ func (r *OIDCProviderResource) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (r *OIDCProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if common.CheckReadOnly(r.client, "delete", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.OIDCProvider)
 	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -88,6 +88,10 @@ func (in *prAutomationTriggerResource) Configure(_ context.Context, request reso
 }
 
 func (in *prAutomationTriggerResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if common.CheckReadOnly(in.client, "create", &response.Diagnostics) {
+		return
+	}
+
 	data := new(model.PrAutomationTrigger)
 	response.Diagnostics.Append(request.Plan.Get(ctx, data)...)
 	if response.Diagnostics.HasError() {
@@ -102,7 +106,7 @@ func (in *prAutomationTriggerResource) Create(ctx context.Context, request resou
 		data.ContextJson(ctx, &response.Diagnostics),
 	)
 	if err != nil {
-		response.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create pull request, got error: %s", err))
+		common.AddClientError(ctx, &response.Diagnostics, request.Plan.Schema, "Unable to create pull request", err)
 		return
 	}
 
@@ -114,6 +118,10 @@ func (in *prAutomationTriggerResource) Read(_ context.Context, _ resource.ReadRe
 }
 
 func (in *prAutomationTriggerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if common.CheckReadOnly(in.client, "update", &resp.Diagnostics) {
+		return
+	}
+
 	var data, state model.PrAutomationTrigger
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -130,7 +138,7 @@ func (in *prAutomationTriggerResource) Update(ctx context.Context, req resource.
 			data.ContextJson(ctx, &resp.Diagnostics),
 		)
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create pull request, got error: %s", err))
+			common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create pull request", err)
 			return
 		}
 	}
@@ -138,7 +146,11 @@ func (in *prAutomationTriggerResource) Update(ctx context.Context, req resource.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (in *prAutomationTriggerResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+func (in *prAutomationTriggerResource) Delete(_ context.Context, _ resource.DeleteRequest, response *resource.DeleteResponse) {
+	if common.CheckReadOnly(in.client, "delete", &response.Diagnostics) {
+		return
+	}
+
 	// Since this is only a trigger, there is no delete API. Ignore.
 }
 
@@ -3,13 +3,16 @@ package resource
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"terraform-provider-plural/internal/model"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 
 	"terraform-provider-plural/internal/client"
 	"terraform-provider-plural/internal/common"
@@ -40,57 +43,70 @@ func (r *rbacResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description:         "The cluster id for these rbac settings",
 				MarkdownDescription: "The cluster id for these rbac settings",
 				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"service_id": schema.StringAttribute{
 				Description:         "The service id for these rbac settings",
 				MarkdownDescription: "The service id for these rbac settings",
 				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
-			"bindings": schema.SingleNestedAttribute{
-				Description:         "Read and write policies of this resource.",
-				MarkdownDescription: "Read and write policies of this resource.",
+			"bindings": r.schemaBindings(
+				"Read and write policies of this resource.",
+				[]planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
+			),
+			"baseline_bindings": r.schemaBindings(
+				"Read and write policies restored when this resource is destroyed. If not set, all policies are removed on destroy.",
+				nil,
+			),
+		},
+	}
+}
+
+func (r *rbacResource) schemaBindings(description string, planModifiers []planmodifier.Object) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description:         description,
+		MarkdownDescription: description,
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"read": schema.SetNestedAttribute{
 				Optional:            true,
-				Attributes: map[string]schema.Attribute{
-					"read": schema.SetNestedAttribute{
-						Optional:            true,
-						Description:         "Read policies of this resource.",
-						MarkdownDescription: "Read policies of this resource.",
-						NestedObject: schema.NestedAttributeObject{
-							Attributes: map[string]schema.Attribute{
-								"group_id": schema.StringAttribute{
-									Optional: true,
-								},
-								"id": schema.StringAttribute{
-									Optional: true,
-								},
-								"user_id": schema.StringAttribute{
-									Optional: true,
-								},
-							},
+				Description:         "Read policies of this resource.",
+				MarkdownDescription: "Read policies of this resource.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"id": schema.StringAttribute{
+							Optional: true,
+						},
+						"user_id": schema.StringAttribute{
+							Optional: true,
 						},
 					},
-					"write": schema.SetNestedAttribute{
-						Optional:            true,
-						Description:         "Write policies of this resource.",
-						MarkdownDescription: "Write policies of this resource.",
-						NestedObject: schema.NestedAttributeObject{
-							Attributes: map[string]schema.Attribute{
-								"group_id": schema.StringAttribute{
-									Optional: true,
-								},
-								"id": schema.StringAttribute{
-									Optional: true,
-								},
-								"user_id": schema.StringAttribute{
-									Optional: true,
-								},
-							},
+				},
+			},
+			"write": schema.SetNestedAttribute{
+				Optional:            true,
+				Description:         "Write policies of this resource.",
+				MarkdownDescription: "Write policies of this resource.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"id": schema.StringAttribute{
+							Optional: true,
+						},
+						"user_id": schema.StringAttribute{
+							Optional: true,
 						},
 					},
 				},
-				PlanModifiers: []planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
 			},
 		},
+		PlanModifiers: planModifiers,
 	}
 }
 
@@ -113,6 +129,10 @@ func (r *rbacResource) Configure(_ context.Context, req resource.ConfigureReques
 }
 
 func (r *rbacResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if common.CheckReadOnly(r.client, "create", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.RBAC)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -121,18 +141,49 @@ func (r *rbacResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	_, err := r.client.UpdateRbac(ctx, data.Attributes(ctx, &resp.Diagnostics), data.ServiceId.ValueStringPointer(), data.ClusterId.ValueStringPointer(), nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update RBAC, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update RBAC", err)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *rbacResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
-	// ignore
+func (r *rbacResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := new(model.RBAC)
+	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response *client.Bindings
+	var err error
+	switch {
+	case !data.ClusterId.IsNull():
+		response, err = r.client.GetClusterBindings(ctx, data.ClusterId.ValueString())
+	case !data.ServiceId.IsNull():
+		response, err = r.client.GetServiceBindings(ctx, data.ServiceId.ValueString())
+	default:
+		return
+	}
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RBAC, got error: %s", err))
+		return
+	}
+	if response == nil || client.IsNotFound(err) {
+		// Target cluster or service not found, remove from state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.From(response, ctx, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
 func (r *rbacResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if common.CheckReadOnly(r.client, "update", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.RBAC)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -141,16 +192,46 @@ func (r *rbacResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	_, err := r.client.UpdateRbac(ctx, data.Attributes(ctx, &resp.Diagnostics), data.ServiceId.ValueStringPointer(), data.ClusterId.ValueStringPointer(), nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update RBAC, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update RBAC", err)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *rbacResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
-	// ignore
+func (r *rbacResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if common.CheckReadOnly(r.client, "delete", &resp.Diagnostics) {
+		return
+	}
+
+	data := new(model.RBAC)
+	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetRbac(
+		ctx,
+		data.BaselineBindings.ReadAttributes(ctx, &resp.Diagnostics),
+		data.BaselineBindings.WriteAttributes(ctx, &resp.Diagnostics),
+		data.ServiceId.ValueStringPointer(),
+		data.ClusterId.ValueStringPointer(),
+	)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset RBAC, got error: %s", err))
+		return
+	}
 }
 
-func (r *rbacResource) ImportState(_ context.Context, _ resource.ImportStateRequest, _ *resource.ImportStateResponse) {
+func (r *rbacResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	kind, id, ok := strings.Cut(req.ID, ":")
+	if !ok || id == "" || (kind != "cluster" && kind != "service") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: cluster:<id> or service:<id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(kind+"_id"), id)...)
 }
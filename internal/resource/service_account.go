@@ -18,6 +18,7 @@ import (
 
 var _ resource.Resource = &ServiceAccountResource{}
 var _ resource.ResourceWithImportState = &ServiceAccountResource{}
+var _ resource.ResourceWithModifyPlan = &ServiceAccountResource{}
 
 func NewServiceAccountResource() resource.Resource {
 	return &ServiceAccountResource{}
@@ -80,7 +81,15 @@ func (r *ServiceAccountResource) Configure(_ context.Context, req resource.Confi
 	r.client = data.Client
 }
 
+func (r *ServiceAccountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanRequireFeature(ctx, r.client, client.FeatureServiceAccounts, req, resp)
+}
+
 func (r *ServiceAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if common.CheckReadOnly(r.client, "create", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.ServiceAccount)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -89,7 +98,7 @@ func (r *ServiceAccountResource) Create(ctx context.Context, req resource.Create
 
 	response, err := r.client.CreateServiceAccount(ctx, data.Attributes())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create service account, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create service account", err)
 		return
 	}
 
@@ -120,6 +129,10 @@ func (r *ServiceAccountResource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *ServiceAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if common.CheckReadOnly(r.client, "update", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.ServiceAccount)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -128,14 +141,18 @@ func (r *ServiceAccountResource) Update(ctx context.Context, req resource.Update
 
 	_, err := r.client.UpdateServiceAccount(ctx, data.Id.ValueString(), data.Attributes())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update service account, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update service account", err)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
-func (r *ServiceAccountResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+func (r *ServiceAccountResource) Delete(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if common.CheckReadOnly(r.client, "delete", &resp.Diagnostics) {
+		return
+	}
+
 	// Ignore.
 }
 
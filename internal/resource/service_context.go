@@ -19,6 +19,7 @@ import (
 
 var _ resource.Resource = &ServiceContextResource{}
 var _ resource.ResourceWithImportState = &ServiceContextResource{}
+var _ resource.ResourceWithModifyPlan = &ServiceContextResource{}
 
 func NewServiceContextResource() resource.Resource {
 	return &ServiceContextResource{}
@@ -26,7 +27,8 @@ func NewServiceContextResource() resource.Resource {
 
 // ServiceContextResource defines the serviceContext resource implementation.
 type ServiceContextResource struct {
-	client *client.Client
+	client   *client.Client
+	defaults *common.Defaults
 }
 
 func (r *ServiceContextResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -50,10 +52,11 @@ func (r *ServiceContextResource) Schema(_ context.Context, _ resource.SchemaRequ
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"project_id": schema.StringAttribute{
-				Description:         "ID of the project that this service context belongs to.",
-				MarkdownDescription: "ID of the project that this service context belongs to.",
+				Description:         "ID of the project that this service context belongs to. Defaults to the provider default_project_id.",
+				MarkdownDescription: "ID of the project that this service context belongs to. Defaults to the provider `default_project_id`.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"configuration": schema.StringAttribute{
 				Description:         "Configuration in JSON format. Use 'jsonencode' and 'jsondecode' methods to encode and decode data.",
@@ -87,9 +90,18 @@ func (r *ServiceContextResource) Configure(_ context.Context, req resource.Confi
 	}
 
 	r.client = data.Client
+	r.defaults = data.Defaults
+}
+
+func (r *ServiceContextResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultProject(ctx, r.defaults, req, resp)
 }
 
 func (r *ServiceContextResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if common.CheckReadOnly(r.client, "create", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.ServiceContextExtended)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -98,7 +110,7 @@ func (r *ServiceContextResource) Create(ctx context.Context, req resource.Create
 
 	sc, err := r.client.SaveServiceContext(ctx, data.Name.ValueString(), data.Attributes(ctx, &resp.Diagnostics))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create service context, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create service context", err)
 		return
 	}
 
@@ -129,6 +141,10 @@ func (r *ServiceContextResource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *ServiceContextResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if common.CheckReadOnly(r.client, "update", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.ServiceContextExtended)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -137,7 +153,7 @@ func (r *ServiceContextResource) Update(ctx context.Context, req resource.Update
 
 	_, err := r.client.SaveServiceContext(ctx, data.Name.ValueString(), data.Attributes(ctx, &resp.Diagnostics))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update service context, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update service context", err)
 		return
 	}
 
@@ -145,6 +161,10 @@ func (r *ServiceContextResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *ServiceContextResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if common.CheckReadOnly(r.client, "delete", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.ServiceContextExtended)
 	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -3,13 +3,16 @@ package resource
 import (
 	"context"
 	"fmt"
-	"time"
+	"strings"
 
 	"terraform-provider-plural/internal/common"
 	"terraform-provider-plural/internal/model"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	gqlclient "github.com/pluralsh/console/go/client"
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	"terraform-provider-plural/internal/client"
@@ -17,6 +20,7 @@ import (
 
 var _ resource.Resource = &ServiceDeploymentResource{}
 var _ resource.ResourceWithImportState = &ServiceDeploymentResource{}
+var _ resource.ResourceWithModifyPlan = &ServiceDeploymentResource{}
 
 func NewServiceDeploymentResource() resource.Resource {
 	return &ServiceDeploymentResource{}
@@ -31,8 +35,8 @@ func (r *ServiceDeploymentResource) Metadata(_ context.Context, req resource.Met
 	resp.TypeName = req.ProviderTypeName + "_service_deployment"
 }
 
-func (r *ServiceDeploymentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = r.schema()
+func (r *ServiceDeploymentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = r.schema(ctx)
 }
 
 func (r *ServiceDeploymentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -53,22 +57,74 @@ func (r *ServiceDeploymentResource) Configure(_ context.Context, req resource.Co
 	r.client = data.Client
 }
 
+func (r *ServiceDeploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configuration, secrets types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("configuration"), &configuration)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secrets"), &secrets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !configuration.IsNull() && !configuration.IsUnknown() && !secrets.IsNull() && !secrets.IsUnknown() {
+		for name := range secrets.Elements() {
+			if _, ok := configuration.Elements()[name]; ok {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("secrets").AtMapKey(name),
+					"Duplicate Configuration Key",
+					fmt.Sprintf("Key %q is defined in both configuration and secrets.", name),
+				)
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_hashes"), model.SecretHashesFrom(secrets, ctx, &resp.Diagnostics))...)
+}
+
 func (r *ServiceDeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if common.CheckReadOnly(r.client, "create", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.ServiceDeployment)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secrets"), &data.Secrets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	attrs := data.Attributes(ctx, &resp.Diagnostics)
+	attrs.ContextBindings = r.contextBindings(ctx, data.Contexts, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sd, err := r.client.CreateServiceDeployment(ctx, data.Cluster.Id.ValueStringPointer(), data.Cluster.Handle.ValueStringPointer(), attrs)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ServiceDeployment, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create ServiceDeployment", err)
 		return
 	}
 
 	data.FromCreate(sd, &resp.Diagnostics)
+	data.Secrets = types.MapNull(types.StringType)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitFor(ctx, data, &resp.Diagnostics)
 }
 
 func (r *ServiceDeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -89,39 +145,117 @@ func (r *ServiceDeploymentResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	data.FromGet(response.ServiceDeployment, &resp.Diagnostics)
+	relations, err := r.client.GetServiceDeploymentRelations(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ServiceDeployment dependencies and contexts, got error: %s", err))
+		return
+	}
+
+	data.FromGet(response.ServiceDeployment, relations, ctx, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
 func (r *ServiceDeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	data := new(model.ServiceDeployment)
+	if common.CheckReadOnly(r.client, "update", &resp.Diagnostics) {
+		return
+	}
+
+	data, state := new(model.ServiceDeployment), new(model.ServiceDeployment)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secrets"), &data.Secrets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	attrs := data.UpdateAttributes(ctx, &resp.Diagnostics)
+	attrs.ContextBindings = r.contextBindings(ctx, data.Contexts, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	_, err := r.client.UpdateServiceDeployment(ctx, data.Id.ValueString(), data.UpdateAttributes(ctx, &resp.Diagnostics))
+	_, err := r.client.UpdateServiceDeployment(ctx, data.Id.ValueString(), attrs)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update ServiceDeployment, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update ServiceDeployment", err)
 		return
 	}
 
+	if dependencies, contexts := data.RemovedRelations(state); dependencies || contexts {
+		if err = r.client.ClearServiceDeploymentRelations(ctx, data.Id.ValueString(), dependencies, contexts); err != nil {
+			common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to remove ServiceDeployment dependencies and contexts", err)
+			return
+		}
+	}
+
+	data.Secrets = types.MapNull(types.StringType)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitFor(ctx, data, &resp.Diagnostics)
+}
+
+// contextBindings resolves service contexts referenced by names or IDs into context bindings.
+func (r *ServiceDeploymentResource) contextBindings(ctx context.Context, contexts types.Set, d *diag.Diagnostics) []*gqlclient.ContextBindingAttributes {
+	if contexts.IsNull() || contexts.IsUnknown() {
+		return nil
+	}
+
+	values := make([]string, 0, len(contexts.Elements()))
+	d.Append(contexts.ElementsAs(ctx, &values, false)...)
+
+	result := make([]*gqlclient.ContextBindingAttributes, 0, len(values))
+	for _, value := range values {
+		response, err := r.client.GetServiceContext(ctx, value)
+		if err != nil && !client.IsNotFound(err) {
+			d.AddError("Client Error", fmt.Sprintf("Unable to read service context %s, got error: %s", value, err))
+			continue
+		}
+		if response == nil || response.ServiceContext == nil || client.IsNotFound(err) {
+			// Not a name of an existing service context, use it as an ID.
+			result = append(result, &gqlclient.ContextBindingAttributes{ContextID: value})
+			continue
+		}
+
+		result = append(result, &gqlclient.ContextBindingAttributes{ContextID: response.ServiceContext.ID})
+	}
+
+	return result
 }
 
 func (r *ServiceDeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if common.CheckReadOnly(r.client, "delete", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.ServiceDeployment)
 	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if _, err := r.client.DeleteServiceDeployment(ctx, data.Id.ValueString()); err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete ServiceDeployment, got error: %s", err))
 		return
 	}
 
-	if err := wait.PollUntilContextTimeout(ctx, 10*time.Second, 10*time.Minute, true, func(ctx context.Context) (bool, error) {
+	if err := wait.PollUntilContextTimeout(ctx, pollInterval, deleteTimeout, true, func(ctx context.Context) (bool, error) {
 		response, err := r.client.GetServiceDeployment(ctx, data.Id.ValueString())
 		if client.IsNotFound(err) {
 			return true, nil
@@ -139,5 +273,29 @@ func (r *ServiceDeploymentResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *ServiceDeploymentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if !strings.HasPrefix(req.ID, "@") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	handle, name, ok := strings.Cut(req.ID[1:], "/")
+	if !ok || handle == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <id> or @<cluster-handle>/<service-name>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	response, err := r.client.GetServiceDeploymentByHandle(ctx, handle, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ServiceDeployment, got error: %s", err))
+		return
+	}
+	if response == nil || response.ServiceDeployment == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find ServiceDeployment %s in cluster %s", name, handle))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), response.ServiceDeployment.ID)...)
 }
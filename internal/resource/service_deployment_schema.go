@@ -1,6 +1,10 @@
 package resource
 
 import (
+	"context"
+
+	customvalidator "terraform-provider-plural/internal/validator"
+
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -11,12 +15,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func (r *ServiceDeploymentResource) schema() schema.Schema {
+func (r *ServiceDeploymentResource) schema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: "Service deployment represents a Kubernetes service deployed and managed by Plural Console. It defines how applications are deployed to clusters using GitOps principles, supporting multiple deployment methods including Helm charts, Kustomize, and raw manifests. Services can be templated, configured with secrets and dependencies, and monitored for health and sync status across the deployment lifecycle.",
 		Attributes: map[string]schema.Attribute{
@@ -82,11 +87,41 @@ func (r *ServiceDeploymentResource) schema() schema.Schema {
 				PlanModifiers:       []planmodifier.Map{mapplanmodifier.UseStateForUnknown()},
 				Default:             mapdefault.StaticValue(types.MapNull(types.StringType)),
 			},
+			"secrets": schema.MapAttribute{
+				Description:         "Key-value secrets used to parameterize this service. Secrets are sent along with the configuration, but are never stored in the state. Requires Terraform 1.11 or later.",
+				MarkdownDescription: "Key-value secrets used to parameterize this service. Secrets are sent along with the configuration, but are never stored in the state. Requires Terraform 1.11 or later.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				ElementType:         types.StringType,
+			},
+			"secret_hashes": schema.MapAttribute{
+				Description:         "SHA-256 hashes of secrets keyed by their names. Used to detect changes of secrets.",
+				MarkdownDescription: "SHA-256 hashes of secrets keyed by their names. Used to detect changes of secrets.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"dependencies": schema.SetAttribute{
+				Description:         "Names of services on the same cluster that have to be healthy before this service is deployed.",
+				MarkdownDescription: "Names of services on the same cluster that have to be healthy before this service is deployed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"contexts": schema.SetAttribute{
+				Description:         "Names or IDs of service contexts bound to this service.",
+				MarkdownDescription: "Names or IDs of service contexts bound to this service.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
 			"cluster":     r.schemaCluster(),
 			"repository":  r.schemaRepository(),
 			"bindings":    r.schemaBindings(),
 			"sync_config": r.schemaSyncConfig(),
 			"helm":        r.schemaHelm(),
+			"wait_for":    r.schemaWaitFor(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -105,24 +140,39 @@ func (r *ServiceDeploymentResource) schemaKustomize() schema.SingleNestedAttribu
 	}
 }
 
-// func (r *ServiceDeploymentResource) schemaConfiguration() schema.SetNestedAttribute {
-// 	return schema.SetNestedAttribute{
-// 		Optional:            true,
-// 		Description:         "List of [name, value] secrets used to alter this ServiceDeployment configuration.",
-// 		MarkdownDescription: "List of [name, value] secrets used to alter this ServiceDeployment configuration.",
-// 		NestedObject: schema.NestedAttributeObject{
-// 			Attributes: map[string]schema.Attribute{
-// 				"name": schema.StringAttribute{
-// 					Required: true,
-// 				},
-// 				"value": schema.StringAttribute{
-// 					Required:  true,
-// 					Sensitive: true,
-// 				},
-// 			},
-// 		},
-// 	}
-// }
+func (r *ServiceDeploymentResource) schemaWaitFor() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		Description:         "If set, create and update wait for this ServiceDeployment to reach the target status.",
+		MarkdownDescription: "If set, create and update wait for this ServiceDeployment to reach the target status.",
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(serviceDeploymentWaitForHealthy),
+				Description:         "Target status of this ServiceDeployment, one of healthy or synced. Healthy services are considered synced as well. Defaults to healthy.",
+				MarkdownDescription: "Target status of this ServiceDeployment, one of `healthy` or `synced`. Healthy services are considered synced as well. Defaults to `healthy`.",
+				Validators:          []validator.String{stringvalidator.OneOf(serviceDeploymentWaitForHealthy, serviceDeploymentWaitForSynced)},
+			},
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("10m"),
+				Description:         "Maximum duration to wait for the target status. Defaults to 10 minutes.",
+				MarkdownDescription: "Maximum duration to wait for the target status. Defaults to 10 minutes.",
+				Validators:          []validator.String{customvalidator.Duration()},
+			},
+			"poll_interval": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("10s"),
+				Description:         "Interval between status checks. Defaults to 10 seconds.",
+				MarkdownDescription: "Interval between status checks. Defaults to 10 seconds.",
+				Validators:          []validator.String{customvalidator.Duration()},
+			},
+		},
+	}
+}
 
 func (r *ServiceDeploymentResource) schemaCluster() schema.SingleNestedAttribute {
 	return schema.SingleNestedAttribute{
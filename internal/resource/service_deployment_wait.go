@@ -0,0 +1,126 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"terraform-provider-plural/internal/client"
+	"terraform-provider-plural/internal/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	console "github.com/pluralsh/console/go/client"
+	"github.com/samber/lo"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	serviceDeploymentWaitForHealthy = "healthy"
+	serviceDeploymentWaitForSynced  = "synced"
+)
+
+// serviceDeploymentTargetStatuses maps wait_for statuses to service statuses that satisfy them.
+var serviceDeploymentTargetStatuses = map[string][]console.ServiceDeploymentStatus{
+	serviceDeploymentWaitForHealthy: {console.ServiceDeploymentStatusHealthy},
+	serviceDeploymentWaitForSynced:  {console.ServiceDeploymentStatusSynced, console.ServiceDeploymentStatusHealthy},
+}
+
+// serviceDeploymentStatusGetter returns the current status of the awaited service.
+type serviceDeploymentStatusGetter func(ctx context.Context) (*client.ServiceDeploymentStatus, error)
+
+// waitForServiceDeployment polls the service until it reaches one of the target statuses. It fails
+// fast if the service reports the FAILED status, unless it is one of the target statuses. It stops
+// early if the context is cancelled, i.e. when Terraform is interrupted. The last known status of
+// the service is returned along with the error to help describe the failure.
+func waitForServiceDeployment(ctx context.Context, get serviceDeploymentStatusGetter, statuses []console.ServiceDeploymentStatus, interval, timeout time.Duration) (*client.ServiceDeploymentStatus, error) {
+	var last *client.ServiceDeploymentStatus
+	err := wait.PollUntilContextTimeout(ctx, interval, timeout, true, func(ctx context.Context) (bool, error) {
+		status, err := get(ctx)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("failed to get service status, got error: %s", err.Error()))
+			return false, nil
+		}
+		if status == nil {
+			return false, nil
+		}
+
+		last = status
+		tflog.Debug(ctx, fmt.Sprintf("service %s is %s", status.Name, status.Status))
+		if lo.Contains(statuses, status.Status) {
+			return true, nil
+		}
+
+		if status.Status == console.ServiceDeploymentStatusFailed {
+			return false, fmt.Errorf("service %s has failed", status.Name)
+		}
+
+		return false, nil
+	})
+
+	return last, err
+}
+
+func (r *ServiceDeploymentResource) waitFor(ctx context.Context, data *model.ServiceDeployment, d *diag.Diagnostics) {
+	if data.WaitFor == nil {
+		return
+	}
+
+	timeout, err := data.WaitFor.ParseTimeout()
+	if err != nil {
+		d.AddError("Invalid Configuration", fmt.Sprintf("Unable to parse wait_for timeout, got error: %s", err))
+		return
+	}
+
+	interval, err := data.WaitFor.ParsePollInterval()
+	if err != nil {
+		d.AddError("Invalid Configuration", fmt.Sprintf("Unable to parse wait_for poll interval, got error: %s", err))
+		return
+	}
+
+	target := data.WaitFor.Status.ValueString()
+	tflog.Info(ctx, fmt.Sprintf("waiting up to %s for service %s to become %s", timeout, data.Name.ValueString(), target))
+	get := func(ctx context.Context) (*client.ServiceDeploymentStatus, error) {
+		return r.client.GetServiceDeploymentStatus(ctx, data.Id.ValueString())
+	}
+
+	status, err := waitForServiceDeployment(ctx, get, serviceDeploymentTargetStatuses[target], interval, timeout)
+	if err != nil {
+		d.AddError(
+			"Service Not Ready",
+			fmt.Sprintf("Service %s did not become %s within %s, got error: %s%s", data.Name.ValueString(), target, timeout, err, serviceDeploymentStatusDetails(status)),
+		)
+	}
+}
+
+// serviceDeploymentStatusDetails describes the last known status, errors and unhealthy components of the service.
+func serviceDeploymentStatusDetails(status *client.ServiceDeploymentStatus) string {
+	if status == nil {
+		return ""
+	}
+
+	sb := new(strings.Builder)
+	fmt.Fprintf(sb, "\n\nLast known status: %s", status.Status)
+
+	for _, e := range status.Errors {
+		if e != nil {
+			fmt.Fprintf(sb, "\nError from %s: %s", e.Source, e.Message)
+		}
+	}
+
+	for _, c := range status.Components {
+		if c == nil || (c.Synced && lo.FromPtr(c.State) == "RUNNING") {
+			continue
+		}
+
+		name := c.Name
+		if namespace := lo.FromPtr(c.Namespace); namespace != "" {
+			name = namespace + "/" + name
+		}
+
+		fmt.Fprintf(sb, "\nComponent %s %s is %s (synced: %t)", c.Kind, name, lo.FromPtrOr(c.State, "UNKNOWN"), c.Synced)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,221 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"terraform-provider-plural/internal/client"
+	"terraform-provider-plural/internal/common"
+	customvalidator "terraform-provider-plural/internal/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	console "github.com/pluralsh/console/go/client"
+	"github.com/pluralsh/polly/algorithms"
+)
+
+// serviceWaitOptions contains wait settings shared by plural_service_wait and plural_services_wait.
+type serviceWaitOptions struct {
+	Warmup       types.String `tfsdk:"warmup"`
+	Duration     types.String `tfsdk:"duration"`
+	PollInterval types.String `tfsdk:"poll_interval"`
+	Statuses     types.List   `tfsdk:"statuses"`
+}
+
+func (in *serviceWaitOptions) ParseWarmup() (time.Duration, error) {
+	return time.ParseDuration(in.Warmup.ValueString())
+}
+
+func (in *serviceWaitOptions) ParseDuration() (time.Duration, error) {
+	return time.ParseDuration(in.Duration.ValueString())
+}
+
+func (in *serviceWaitOptions) ParsePollInterval() (time.Duration, error) {
+	return time.ParseDuration(in.PollInterval.ValueString())
+}
+
+func (in *serviceWaitOptions) TargetStatuses(ctx context.Context) ([]console.ServiceDeploymentStatus, diag.Diagnostics) {
+	statuses := make([]string, 0, len(in.Statuses.Elements()))
+	diags := in.Statuses.ElementsAs(ctx, &statuses, false)
+	return algorithms.Map(statuses, func(s string) console.ServiceDeploymentStatus {
+		return console.ServiceDeploymentStatus(s)
+	}), diags
+}
+
+// serviceWaitOptionsSchema returns schema of the shared wait settings, subject describes awaited services in descriptions.
+func serviceWaitOptionsSchema(subject string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"warmup": schema.StringAttribute{
+			Description:         fmt.Sprintf("Initial delay before checking the %s health. Defaults to 5 minutes.", subject),
+			MarkdownDescription: fmt.Sprintf("Initial delay before checking the %s health. Defaults to 5 minutes.", subject),
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("5m"),
+			Validators:          []validator.String{customvalidator.Duration()},
+		},
+		"duration": schema.StringAttribute{
+			Description:         fmt.Sprintf("Maximum duration to wait for the %s to become healthy. Minimum 1 minute. Defaults to 10 minutes.", subject),
+			MarkdownDescription: fmt.Sprintf("Maximum duration to wait for the %s to become healthy. Minimum 1 minute. Defaults to 10 minutes.", subject),
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("10m"),
+			Validators:          []validator.String{customvalidator.MinDuration(time.Minute)},
+		},
+		"poll_interval": schema.StringAttribute{
+			Description:         fmt.Sprintf("Interval between %s health checks. Minimum 1 second. Defaults to 30 seconds.", subject),
+			MarkdownDescription: fmt.Sprintf("Interval between %s health checks. Minimum 1 second. Defaults to 30 seconds.", subject),
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("30s"),
+			Validators:          []validator.String{customvalidator.MinDuration(time.Second)},
+		},
+		"statuses": schema.ListAttribute{
+			Description:         "Statuses that service deployments are expected to reach. The wait fails early for service deployments that reach the FAILED status, unless it is listed here. Defaults to HEALTHY.",
+			MarkdownDescription: "Statuses that service deployments are expected to reach. The wait fails early for service deployments that reach the `FAILED` status, unless it is listed here. Defaults to `HEALTHY`.",
+			Optional:            true,
+			Computed:            true,
+			ElementType:         types.StringType,
+			Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue(string(console.ServiceDeploymentStatusHealthy))})),
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(stringvalidator.OneOf(
+					string(console.ServiceDeploymentStatusHealthy),
+					string(console.ServiceDeploymentStatusSynced),
+					string(console.ServiceDeploymentStatusStale),
+					string(console.ServiceDeploymentStatusPaused),
+					string(console.ServiceDeploymentStatusFailed),
+				)),
+			},
+		},
+	}
+}
+
+// serviceWaitTarget is a single service awaited by the service wait resources.
+type serviceWaitTarget struct {
+	name string
+	get  serviceDeploymentStatusGetter
+}
+
+// waitForServices waits for the warmup period and then polls all targets concurrently until they reach one of
+// the target statuses. Targets are resolved after the warmup, so that services created in the meantime are included.
+func waitForServices(ctx context.Context, options *serviceWaitOptions, targets func(context.Context) ([]serviceWaitTarget, diag.Diagnostics)) (diags diag.Diagnostics) {
+	warmup, err := options.ParseWarmup()
+	if err != nil {
+		diags.AddError("Invalid Configuration", fmt.Sprintf("Unable to parse warmup duration, got error: %s", err))
+		return
+	}
+
+	duration, err := options.ParseDuration()
+	if err != nil {
+		diags.AddError("Invalid Configuration", fmt.Sprintf("Unable to parse duration, got error: %s", err))
+		return
+	}
+
+	interval, err := options.ParsePollInterval()
+	if err != nil {
+		diags.AddError("Invalid Configuration", fmt.Sprintf("Unable to parse poll interval, got error: %s", err))
+		return
+	}
+
+	statuses, d := options.TargetStatuses(ctx)
+	if diags.Append(d...); diags.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("waiting for warmup period of %s before starting health checks...", warmup))
+	select {
+	case <-ctx.Done():
+		diags.AddError("Client Error", fmt.Sprintf("Warmup period interrupted: %s", ctx.Err()))
+		return
+	case <-time.After(warmup):
+	}
+	tflog.Info(ctx, "warmup period completed, starting health checks")
+
+	resolved, d := targets(ctx)
+	if diags.Append(d...); diags.HasError() {
+		return
+	}
+
+	type result struct {
+		status *client.ServiceDeploymentStatus
+		err    error
+	}
+
+	results := make([]result, len(resolved))
+	wg := sync.WaitGroup{}
+	for i, target := range resolved {
+		wg.Go(func() {
+			status, err := waitForServiceDeployment(ctx, target.get, statuses, interval, duration)
+			results[i] = result{status: status, err: err}
+		})
+	}
+	wg.Wait()
+
+	for i, target := range resolved {
+		if results[i].err != nil {
+			diags.AddError(
+				"Service Not Ready",
+				fmt.Sprintf("Service %s did not reach %v within %s, got error: %s%s", target.name, statuses, duration, results[i].err, serviceDeploymentStatusDetails(results[i].status)),
+			)
+			continue
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("service %s health check completed successfully", target.name))
+	}
+
+	return diags
+}
+
+// serviceWaitResourceBase implements lifecycle methods shared by the service wait resources. Waiting happens
+// on create only, other operations just keep the planned values.
+type serviceWaitResourceBase struct {
+	client *client.Client
+}
+
+func (in *serviceWaitResourceBase) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	data, ok := request.ProviderData.(*common.ProviderData)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Service Wait Resource Configure Type",
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+		return
+	}
+
+	in.client = data.Client
+}
+
+func (in *serviceWaitResourceBase) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+	// Ignore.
+}
+
+func (in *serviceWaitResourceBase) Update(_ context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if common.CheckReadOnly(in.client, "update", &resp.Diagnostics) {
+		return
+	}
+
+	resp.State.Raw = req.Plan.Raw
+}
+
+func (in *serviceWaitResourceBase) Delete(_ context.Context, _ resource.DeleteRequest, response *resource.DeleteResponse) {
+	if common.CheckReadOnly(in.client, "delete", &response.Diagnostics) {
+		return
+	}
+
+	// Ignore.
+}
@@ -0,0 +1,147 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"terraform-provider-plural/internal/client"
+	"terraform-provider-plural/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pluralsh/polly/algorithms"
+)
+
+type servicesWait struct {
+	Targets         []servicesWaitTarget `tfsdk:"targets"`
+	GlobalServiceId types.String         `tfsdk:"global_service_id"`
+	serviceWaitOptions
+}
+
+type servicesWaitTarget struct {
+	Cluster types.String `tfsdk:"cluster"`
+	Service types.String `tfsdk:"service"`
+}
+
+var _ resource.ResourceWithConfigure = &servicesWaitResource{}
+
+func NewServicesWaitResource() resource.Resource {
+	return &servicesWaitResource{}
+}
+
+type servicesWaitResource struct {
+	serviceWaitResourceBase
+}
+
+func (in *servicesWaitResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_services_wait"
+}
+
+func (in *servicesWaitResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"targets": schema.ListNestedAttribute{
+			Description:         "List of service deployments that should be checked.",
+			MarkdownDescription: "List of service deployments that should be checked.",
+			Optional:            true,
+			Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"cluster": schema.StringAttribute{
+						Description:         "Handle of the cluster where the service is deployed.",
+						MarkdownDescription: "Handle of the cluster where the service is deployed.",
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"service": schema.StringAttribute{
+						Description:         "Name the service deployment that should be checked.",
+						MarkdownDescription: "Name the service deployment that should be checked.",
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+				},
+			},
+		},
+		"global_service_id": schema.StringAttribute{
+			Description:         "ID of the global service. All service deployments created from it will be checked.",
+			MarkdownDescription: "ID of the global service. All service deployments created from it will be checked.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.ExactlyOneOf(path.MatchRoot("targets")),
+			},
+		},
+	}
+	maps.Copy(attributes, serviceWaitOptionsSchema("service deployments"))
+
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Services wait provides a utility resource to wait for a set of service deployments, or all services created from a global service, to reach a healthy state before proceeding. Services are checked concurrently.",
+		Attributes:          attributes,
+	}
+}
+
+func (in *servicesWaitResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if common.CheckReadOnly(in.client, "create", &response.Diagnostics) {
+		return
+	}
+
+	data := new(servicesWait)
+	response.Diagnostics.Append(request.Plan.Get(ctx, data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(waitForServices(ctx, &data.serviceWaitOptions, func(ctx context.Context) ([]serviceWaitTarget, diag.Diagnostics) {
+		return in.targets(ctx, data)
+	})...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+// targets returns services that should be checked, either listed explicitly or created from the global service.
+func (in *servicesWaitResource) targets(ctx context.Context, data *servicesWait) ([]serviceWaitTarget, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if data.GlobalServiceId.IsNull() {
+		return algorithms.Map(data.Targets, func(t servicesWaitTarget) serviceWaitTarget {
+			return serviceWaitTarget{
+				name: fmt.Sprintf("%s/%s", t.Cluster.ValueString(), t.Service.ValueString()),
+				get: func(ctx context.Context) (*client.ServiceDeploymentStatus, error) {
+					return in.client.GetServiceDeploymentStatusByHandle(ctx, t.Cluster.ValueString(), t.Service.ValueString())
+				},
+			}
+		}), nil
+	}
+
+	services, err := in.client.GetGlobalServiceServices(ctx, data.GlobalServiceId.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list services of global service, got error: %s", err))
+		return nil, diags
+	}
+	if len(services) == 0 {
+		diags.AddWarning("No Services Found", fmt.Sprintf("Global service %s does not have any services to wait for.", data.GlobalServiceId.ValueString()))
+		return nil, diags
+	}
+
+	return algorithms.Map(services, func(s *client.GlobalServiceService) serviceWaitTarget {
+		name := s.Name
+		if s.Cluster != nil && s.Cluster.Handle != nil {
+			name = fmt.Sprintf("%s/%s", *s.Cluster.Handle, s.Name)
+		}
+
+		return serviceWaitTarget{
+			name: name,
+			get: func(ctx context.Context) (*client.ServiceDeploymentStatus, error) {
+				return in.client.GetServiceDeploymentStatus(ctx, s.ID)
+			},
+		}
+	}), nil
+}
@@ -96,6 +96,10 @@ func (in *sharedSecretResource) Configure(_ context.Context, request resource.Co
 }
 
 func (in *sharedSecretResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if common.CheckReadOnly(in.client, "create", &response.Diagnostics) {
+		return
+	}
+
 	data := new(model.SharedSecret)
 	response.Diagnostics.Append(request.Plan.Get(ctx, data)...)
 	if response.Diagnostics.HasError() {
@@ -121,10 +125,18 @@ func (in *sharedSecretResource) Read(ctx context.Context, request resource.ReadR
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
 
-func (in *sharedSecretResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+func (in *sharedSecretResource) Update(_ context.Context, _ resource.UpdateRequest, response *resource.UpdateResponse) {
+	if common.CheckReadOnly(in.client, "update", &response.Diagnostics) {
+		return
+	}
+
 	// Ignore.
 }
 
-func (in *sharedSecretResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+func (in *sharedSecretResource) Delete(_ context.Context, _ resource.DeleteRequest, response *resource.DeleteResponse) {
+	if common.CheckReadOnly(in.client, "delete", &response.Diagnostics) {
+		return
+	}
+
 	// Ignore.
 }
@@ -71,6 +71,10 @@ func (in *stackRunTriggerResource) Configure(_ context.Context, request resource
 }
 
 func (in *stackRunTriggerResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if common.CheckReadOnly(in.client, "create", &response.Diagnostics) {
+		return
+	}
+
 	data := new(model.StackRunTrigger)
 	response.Diagnostics.Append(request.Plan.Get(ctx, data)...)
 	if response.Diagnostics.HasError() {
@@ -94,6 +98,10 @@ func (in *stackRunTriggerResource) Read(_ context.Context, _ resource.ReadReques
 }
 
 func (in *stackRunTriggerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if common.CheckReadOnly(in.client, "update", &resp.Diagnostics) {
+		return
+	}
+
 	var data, state model.StackRunTrigger
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -115,7 +123,11 @@ func (in *stackRunTriggerResource) Update(ctx context.Context, req resource.Upda
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (in *stackRunTriggerResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+func (in *stackRunTriggerResource) Delete(_ context.Context, _ resource.DeleteRequest, response *resource.DeleteResponse) {
+	if common.CheckReadOnly(in.client, "delete", &response.Diagnostics) {
+		return
+	}
+
 	// Since this is only a trigger, there is no delete API. Ignore.
 }
 
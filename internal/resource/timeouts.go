@@ -0,0 +1,39 @@
+package resource
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// defaultTimeout is used for create, update and delete operations when no timeout is configured.
+	defaultTimeout = 10 * time.Minute
+
+	// clusterDefaultTimeout is used for cluster create and update operations when no timeout is configured.
+	// It is longer than defaultTimeout as these operations also install the deployment agent.
+	clusterDefaultTimeout = 30 * time.Minute
+
+	// pollInterval is used by all loops that wait for the Console to finish an operation.
+	pollInterval = 10 * time.Second
+)
+
+// timeoutsBlock returns the standard timeouts block for resources that wait for the Console.
+func timeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{Create: true, Update: true, Delete: true})
+}
+
+// timeoutsNull returns a null timeouts value matching timeoutsBlock.
+func timeoutsNull() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		}),
+	}
+}
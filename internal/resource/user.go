@@ -83,6 +83,10 @@ func (r *UserResource) Configure(
 }
 
 func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if common.CheckReadOnly(r.client, "create", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.User)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -91,7 +95,7 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	response, err := r.client.UpsertUser(ctx, data.Attributes())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create user", err)
 		return
 	}
 
@@ -122,6 +126,10 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if common.CheckReadOnly(r.client, "update", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.User)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -130,14 +138,18 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	_, err := r.client.UpdateUser(ctx, data.Id.ValueStringPointer(), data.Attributes())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update user", err)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
-func (r *UserResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+func (r *UserResource) Delete(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if common.CheckReadOnly(r.client, "delete", &resp.Diagnostics) {
+		return
+	}
+
 	// Ignore.
 }
 
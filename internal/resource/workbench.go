@@ -22,6 +22,7 @@ import (
 
 var _ resource.Resource = &WorkbenchResource{}
 var _ resource.ResourceWithImportState = &WorkbenchResource{}
+var _ resource.ResourceWithModifyPlan = &WorkbenchResource{}
 
 func NewWorkbenchResource() resource.Resource {
 	return &WorkbenchResource{}
@@ -29,7 +30,8 @@ func NewWorkbenchResource() resource.Resource {
 
 // WorkbenchResource defines the workbench resource implementation.
 type WorkbenchResource struct {
-	client *client.Client
+	client   *client.Client
+	defaults *common.Defaults
 }
 
 func (r *WorkbenchResource) Metadata(
@@ -64,8 +66,8 @@ func (r *WorkbenchResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional:            true,
 			},
 			"project_id": schema.StringAttribute{
-				Description:         "ID of the project that this workbench belongs to.",
-				MarkdownDescription: "ID of the project that this workbench belongs to.",
+				Description:         "ID of the project that this workbench belongs to. Defaults to the provider default_project_id.",
+				MarkdownDescription: "ID of the project that this workbench belongs to. Defaults to the provider `default_project_id`.",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
@@ -263,9 +265,19 @@ func (r *WorkbenchResource) Configure(_ context.Context, req resource.ConfigureR
 	}
 
 	r.client = data.Client
+	r.defaults = data.Defaults
+}
+
+func (r *WorkbenchResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanRequireFeature(ctx, r.client, client.FeatureWorkbenches, req, resp)
+	modifyPlanDefaultProject(ctx, r.defaults, req, resp)
 }
 
 func (r *WorkbenchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if common.CheckReadOnly(r.client, "create", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.Workbench)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -280,7 +292,7 @@ func (r *WorkbenchResource) Create(ctx context.Context, req resource.CreateReque
 
 	response, err := r.client.CreateWorkbench(ctx, *attrs)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workbench, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create workbench", err)
 		return
 	}
 
@@ -311,6 +323,10 @@ func (r *WorkbenchResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *WorkbenchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if common.CheckReadOnly(r.client, "update", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.Workbench)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -325,7 +341,7 @@ func (r *WorkbenchResource) Update(ctx context.Context, req resource.UpdateReque
 
 	_, err = r.client.UpdateWorkbench(ctx, data.Id.ValueString(), *attrs)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update workbench, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update workbench", err)
 		return
 	}
 
@@ -333,6 +349,10 @@ func (r *WorkbenchResource) Update(ctx context.Context, req resource.UpdateReque
 }
 
 func (r *WorkbenchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if common.CheckReadOnly(r.client, "delete", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.Workbench)
 	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
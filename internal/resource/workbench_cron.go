@@ -17,6 +17,7 @@ import (
 
 var _ resource.Resource = &WorkbenchCronResource{}
 var _ resource.ResourceWithImportState = &WorkbenchCronResource{}
+var _ resource.ResourceWithModifyPlan = &WorkbenchCronResource{}
 
 func NewWorkbenchCronResource() resource.Resource {
 	return &WorkbenchCronResource{}
@@ -77,7 +78,15 @@ func (r *WorkbenchCronResource) Configure(_ context.Context, req resource.Config
 	r.client = data.Client
 }
 
+func (r *WorkbenchCronResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanRequireFeature(ctx, r.client, client.FeatureWorkbenches, req, resp)
+}
+
 func (r *WorkbenchCronResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if common.CheckReadOnly(r.client, "create", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.WorkbenchCron)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -86,7 +95,7 @@ func (r *WorkbenchCronResource) Create(ctx context.Context, req resource.CreateR
 
 	response, err := r.client.CreateWorkbenchCron(ctx, data.WorkbenchID.ValueString(), data.Attributes())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workbench cron, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create workbench cron", err)
 		return
 	}
 
@@ -116,6 +125,10 @@ func (r *WorkbenchCronResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *WorkbenchCronResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if common.CheckReadOnly(r.client, "update", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.WorkbenchCron)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -124,7 +137,7 @@ func (r *WorkbenchCronResource) Update(ctx context.Context, req resource.UpdateR
 
 	_, err := r.client.UpdateWorkbenchCron(ctx, data.Id.ValueString(), data.Attributes())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update workbench cron, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update workbench cron", err)
 		return
 	}
 
@@ -132,6 +145,10 @@ func (r *WorkbenchCronResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *WorkbenchCronResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if common.CheckReadOnly(r.client, "delete", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.WorkbenchCron)
 	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
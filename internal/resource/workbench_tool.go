@@ -27,6 +27,7 @@ import (
 
 var _ resource.Resource = &WorkbenchToolResource{}
 var _ resource.ResourceWithImportState = &WorkbenchToolResource{}
+var _ resource.ResourceWithModifyPlan = &WorkbenchToolResource{}
 
 func NewWorkbenchToolResource() resource.Resource {
 	return &WorkbenchToolResource{}
@@ -34,7 +35,8 @@ func NewWorkbenchToolResource() resource.Resource {
 
 // WorkbenchToolResource defines the workbench tool resource implementation.
 type WorkbenchToolResource struct {
-	client *client.Client
+	client   *client.Client
+	defaults *common.Defaults
 }
 
 func (r *WorkbenchToolResource) Metadata(
@@ -84,8 +86,8 @@ func (r *WorkbenchToolResource) Schema(_ context.Context, _ resource.SchemaReque
 				},
 			},
 			"project_id": schema.StringAttribute{
-				Description:         "ID of the project that this workbench belongs to.",
-				MarkdownDescription: "ID of the project that this workbench belongs to.",
+				Description:         "ID of the project that this workbench belongs to. Defaults to the provider default_project_id.",
+				MarkdownDescription: "ID of the project that this workbench belongs to. Defaults to the provider `default_project_id`.",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
@@ -302,9 +304,19 @@ func (r *WorkbenchToolResource) Configure(_ context.Context, req resource.Config
 	}
 
 	r.client = data.Client
+	r.defaults = data.Defaults
+}
+
+func (r *WorkbenchToolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanRequireFeature(ctx, r.client, client.FeatureWorkbenches, req, resp)
+	modifyPlanDefaultProject(ctx, r.defaults, req, resp)
 }
 
 func (r *WorkbenchToolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if common.CheckReadOnly(r.client, "create", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.WorkbenchTool)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -319,7 +331,7 @@ func (r *WorkbenchToolResource) Create(ctx context.Context, req resource.CreateR
 
 	response, err := r.client.CreateWorkbenchTool(ctx, *attrs)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workbench tool, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create workbench tool", err)
 		return
 	}
 
@@ -350,6 +362,10 @@ func (r *WorkbenchToolResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *WorkbenchToolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if common.CheckReadOnly(r.client, "update", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.WorkbenchTool)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -364,7 +380,7 @@ func (r *WorkbenchToolResource) Update(ctx context.Context, req resource.UpdateR
 
 	_, err = r.client.UpdateWorkbenchTool(ctx, data.Id.ValueString(), *attrs)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update workbench tool, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update workbench tool", err)
 		return
 	}
 
@@ -372,6 +388,10 @@ func (r *WorkbenchToolResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *WorkbenchToolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if common.CheckReadOnly(r.client, "delete", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.WorkbenchTool)
 	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -19,6 +19,7 @@ import (
 
 var _ resource.Resource = &WorkbenchWebhookResource{}
 var _ resource.ResourceWithImportState = &WorkbenchWebhookResource{}
+var _ resource.ResourceWithModifyPlan = &WorkbenchWebhookResource{}
 
 func NewWorkbenchWebhookResource() resource.Resource {
 	return &WorkbenchWebhookResource{}
@@ -117,7 +118,15 @@ func (r *WorkbenchWebhookResource) Configure(_ context.Context, req resource.Con
 	r.client = data.Client
 }
 
+func (r *WorkbenchWebhookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanRequireFeature(ctx, r.client, client.FeatureWorkbenches, req, resp)
+}
+
 func (r *WorkbenchWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if common.CheckReadOnly(r.client, "create", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.WorkbenchWebhook)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -126,7 +135,7 @@ func (r *WorkbenchWebhookResource) Create(ctx context.Context, req resource.Crea
 
 	response, err := r.client.CreateWorkbenchWebhook(ctx, data.WorkbenchID.ValueString(), data.Attributes())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workbench webhook, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create workbench webhook", err)
 		return
 	}
 
@@ -156,6 +165,10 @@ func (r *WorkbenchWebhookResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *WorkbenchWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if common.CheckReadOnly(r.client, "update", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.WorkbenchWebhook)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
@@ -164,7 +177,7 @@ func (r *WorkbenchWebhookResource) Update(ctx context.Context, req resource.Upda
 
 	_, err := r.client.UpdateWorkbenchWebhook(ctx, data.Id.ValueString(), data.Attributes())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update workbench webhook, got error: %s", err))
+		common.AddClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update workbench webhook", err)
 		return
 	}
 
@@ -172,6 +185,10 @@ func (r *WorkbenchWebhookResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *WorkbenchWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if common.CheckReadOnly(r.client, "delete", &resp.Diagnostics) {
+		return
+	}
+
 	data := new(model.WorkbenchWebhook)
 	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {